@@ -0,0 +1,70 @@
+package dates
+
+import (
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Iso8601Duration is meant to allow access to time.Duration but successfully encode it into the ISO 8601 duration format (e.g. PT10M, P1D) that Twilio expects.
+type Iso8601Duration struct {
+	time.Duration
+}
+
+// EncodeValues handles adding the ISO 8601 representation of the duration to the given url.Values under the provided key so it can be used directly when encoding request parameters.
+func (d Iso8601Duration) EncodeValues(key string, v *url.Values) error {
+	v.Set(key, d.String())
+
+	return nil
+}
+
+func (d Iso8601Duration) String() string {
+	var builder strings.Builder
+
+	duration := d.Duration
+
+	if duration < 0 {
+		builder.WriteString("-")
+		duration = -duration
+	}
+
+	builder.WriteString("P")
+
+	days := duration / (time.Hour * 24)
+	duration -= days * time.Hour * 24
+
+	if days > 0 {
+		builder.WriteString(strconv.FormatInt(int64(days), 10) + "D")
+	}
+
+	if duration == 0 {
+		if days == 0 {
+			builder.WriteString("T0S")
+		}
+
+		return builder.String()
+	}
+
+	builder.WriteString("T")
+
+	hours := duration / time.Hour
+	duration -= hours * time.Hour
+
+	if hours > 0 {
+		builder.WriteString(strconv.FormatInt(int64(hours), 10) + "H")
+	}
+
+	minutes := duration / time.Minute
+	duration -= minutes * time.Minute
+
+	if minutes > 0 {
+		builder.WriteString(strconv.FormatInt(int64(minutes), 10) + "M")
+	}
+
+	if duration > 0 {
+		builder.WriteString(strconv.FormatFloat(duration.Seconds(), 'f', -1, 64) + "S")
+	}
+
+	return builder.String()
+}
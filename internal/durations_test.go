@@ -0,0 +1,46 @@
+package dates_test
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	dates "github.com/craigpaul/twiligo/internal"
+)
+
+func TestCanFormatDurationsAsIso8601Strings(t *testing.T) {
+	cases := map[time.Duration]string{
+		0:                                 "PT0S",
+		time.Minute * 10:                  "PT10M",
+		time.Hour + time.Minute*30:        "PT1H30M",
+		time.Hour * 24:                    "P1D",
+		time.Hour*26 + time.Second*5:      "P1DT2H5S",
+		time.Millisecond * 1500:           "PT1.5S",
+		-(time.Hour*24*2 + time.Minute*1): "-P2DT1M",
+	}
+
+	for given, expected := range cases {
+		actual := dates.Iso8601Duration{Duration: given}.String()
+
+		if actual != expected {
+			t.Logf("Incorrect duration formatted, expecting [%s], but received [%s]", expected, actual)
+			t.Fail()
+		}
+	}
+}
+
+func TestCanEncodeDurationIntoUrlValues(t *testing.T) {
+	values := url.Values{}
+
+	err := dates.Iso8601Duration{Duration: time.Minute * 10}.EncodeValues("Timers.Inactive", &values)
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+
+	if values.Get("Timers.Inactive") != "PT10M" {
+		t.Logf("Incorrect value encoded, expecting [%s], but received [%s]", "PT10M", values.Get("Timers.Inactive"))
+		t.Fail()
+	}
+}
@@ -0,0 +1,314 @@
+package twiligo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+// This constant is used to represent the type of address that an AddressConfiguration applies to.
+const (
+	SMSAddress AddressType = iota + 1
+	WhatsAppAddress
+	MessengerAddress
+	GBMAddress
+)
+
+// This constant is used to represent what is attached to a conversation that is automatically created for an AddressConfiguration.
+const (
+	DefaultAutoCreation AutoCreationType = iota + 1
+	WebhookAutoCreation
+	StudioAutoCreation
+)
+
+// AddressAutoCreationOptions are all of the options that control whether, and how, a conversation is automatically created when a message arrives at an address.
+type AddressAutoCreationOptions struct {
+	Enabled                *bool            `url:",omitempty"`
+	Type                   AutoCreationType `url:",omitempty"`
+	ConversationServiceSID string           `url:"ConversationServiceSid,omitempty"`
+	WebhookURL             string           `url:"WebhookUrl,omitempty"`
+	WebhookMethod          string           `url:",omitempty"`
+	WebhookFilters         []string         `url:",omitempty"`
+	StudioFlowSID          string           `url:"StudioFlowSid,omitempty"`
+	StudioRetryCount       int              `url:",omitempty"`
+}
+
+// AddressConfiguration represents the Conversations settings for a single address (phone number, WhatsApp sender, etc.), including whether inbound messages automatically create a conversation.
+type AddressConfiguration struct {
+	SID            string      `json:"sid"`
+	AccountSID     string      `json:"account_sid"`
+	Type           AddressType `json:"type"`
+	Address        string      `json:"address"`
+	FriendlyName   *string     `json:"friendly_name"`
+	AddressCountry *string     `json:"address_country"`
+	AutoCreation   struct {
+		Enabled                bool             `json:"enabled"`
+		Type                   AutoCreationType `json:"type"`
+		ConversationServiceSID *string          `json:"conversation_service_sid"`
+		WebhookURL             *string          `json:"webhook_url"`
+		WebhookMethod          *string          `json:"webhook_method"`
+		WebhookFilters         []string         `json:"webhook_filters"`
+		StudioFlowSID          *string          `json:"studio_flow_sid"`
+		StudioRetryCount       *int             `json:"studio_retry_count"`
+	} `json:"auto_creation"`
+	DateCreated time.Time `json:"date_created"`
+	DateUpdated time.Time `json:"date_updated"`
+	URL         string    `json:"url"`
+}
+
+// AddressConfigurationOptions are all of the options that can be provided to a CreateNewAddressConfiguration or UpdateAddressConfiguration call. Note: AddressCountry can only be provided when creating a new address configuration.
+type AddressConfigurationOptions struct {
+	FriendlyName   string                     `url:",omitempty"`
+	AddressCountry string                     `url:",omitempty"`
+	AutoCreation   AddressAutoCreationOptions `url:",omitempty"`
+}
+
+// AddressConfigurationsResponse is the representation of the JSON response from Twilio when listing address configurations.
+type AddressConfigurationsResponse struct {
+	AddressConfigurations []*AddressConfiguration `json:"address_configurations"`
+	Meta                  Meta                    `json:"meta"`
+}
+
+// AddressType is used to define which messaging channel (SMS, WhatsApp, etc.) an address belongs to.
+type AddressType int
+
+// AutoCreationType is used to define whether an automatically created conversation has a webhook, a Studio flow or nothing attached to it.
+type AutoCreationType int
+
+// ListAddressConfigurationsOptions are all of the options that can be provided to a ListAddressConfigurations call.
+type ListAddressConfigurationsOptions struct {
+	PageOptions
+	Type AddressType `url:",omitempty"`
+}
+
+type createNewAddressConfigurationOptions struct {
+	AddressConfigurationOptions
+	Type    AddressType
+	Address string
+}
+
+// CreateNewAddressConfiguration creates a new address configuration in Twilio for the given address.
+func (twilio *Twilio) CreateNewAddressConfiguration(addressType AddressType, address string, options AddressConfigurationOptions) (*AddressConfiguration, error) {
+	params, err := query.Values(createNewAddressConfigurationOptions{
+		AddressConfigurationOptions: options,
+		Type:                        addressType,
+		Address:                     address,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.conversationURL("Configuration/Addresses"), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusCreated {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(AddressConfiguration)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// CreateNewAddressConfigurationForIncomingPhoneNumber creates a new SMS address configuration in Twilio for the given IncomingPhoneNumber so that inbound messages to it are handled as conversations.
+func (twilio *Twilio) CreateNewAddressConfigurationForIncomingPhoneNumber(phoneNumber *IncomingPhoneNumber, autoCreation AddressAutoCreationOptions) (*AddressConfiguration, error) {
+	return twilio.CreateNewAddressConfiguration(SMSAddress, phoneNumber.PhoneNumber, AddressConfigurationOptions{
+		FriendlyName: phoneNumber.FriendlyName,
+		AutoCreation: autoCreation,
+	})
+}
+
+// FetchAddressConfiguration retrieves the address configuration matching the given identifier, which may be either the SID or the address itself, from Twilio.
+func (twilio *Twilio) FetchAddressConfiguration(addressSID string) (*AddressConfiguration, error) {
+	res, err := twilio.get(twilio.conversationURL("Configuration/Addresses/"+url.PathEscape(addressSID)), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(AddressConfiguration)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// ListAddressConfigurations retrieves a single page of address configurations from Twilio.
+func (twilio *Twilio) ListAddressConfigurations(options ListAddressConfigurationsOptions) (*AddressConfigurationsResponse, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.get(twilio.conversationURL("Configuration/Addresses"), &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(AddressConfigurationsResponse)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// UpdateAddressConfiguration will update an existing address configuration in Twilio based on the provided identifier and options.
+func (twilio *Twilio) UpdateAddressConfiguration(addressSID string, options AddressConfigurationOptions) (*AddressConfiguration, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.conversationURL("Configuration/Addresses/"+url.PathEscape(addressSID)), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(AddressConfiguration)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// DeleteAddressConfiguration will completely remove the address configuration matching the given identifier from within Twilio.
+func (twilio *Twilio) DeleteAddressConfiguration(addressSID string) error {
+	res, err := twilio.delete(twilio.conversationURL("Configuration/Addresses/" + url.PathEscape(addressSID)))
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		decoder := json.NewDecoder(res.Body)
+
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return err
+	}
+
+	return nil
+}
+
+// EncodeValues handles adding the given auto creation options to the request parameters using the dot notation (e.g. AutoCreation.Enabled) that Twilio expects.
+func (options AddressAutoCreationOptions) EncodeValues(key string, v *url.Values) error {
+	return encodeNestedValues(key, options, v)
+}
+
+// MarshalJSON handles converting an AddressType into the string representation used by Twilio.
+func (addressType AddressType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(addressType.String())
+}
+
+// UnmarshalJSON handles converting the string representation used by Twilio into an AddressType.
+func (addressType *AddressType) UnmarshalJSON(b []byte) error {
+	var s string
+
+	err := json.Unmarshal(b, &s)
+
+	if err != nil {
+		return err
+	}
+
+	*addressType = ConvertTypeToAddressType(s)
+
+	return nil
+}
+
+func (addressType AddressType) String() string {
+	return map[AddressType]string{
+		SMSAddress:       "sms",
+		WhatsAppAddress:  "whatsapp",
+		MessengerAddress: "messenger",
+		GBMAddress:       "gbm",
+	}[addressType]
+}
+
+// MarshalJSON handles converting an AutoCreationType into the string representation used by Twilio.
+func (autoCreationType AutoCreationType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(autoCreationType.String())
+}
+
+// UnmarshalJSON handles converting the string representation used by Twilio into an AutoCreationType.
+func (autoCreationType *AutoCreationType) UnmarshalJSON(b []byte) error {
+	var s string
+
+	err := json.Unmarshal(b, &s)
+
+	if err != nil {
+		return err
+	}
+
+	*autoCreationType = ConvertTypeToAutoCreationType(s)
+
+	return nil
+}
+
+func (autoCreationType AutoCreationType) String() string {
+	return map[AutoCreationType]string{
+		DefaultAutoCreation: "default",
+		WebhookAutoCreation: "webhook",
+		StudioAutoCreation:  "studio",
+	}[autoCreationType]
+}
@@ -0,0 +1,272 @@
+package twiligo_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+
+	twiligo "github.com/craigpaul/twiligo/pkg"
+)
+
+const addressConfigurationResponse = `{
+	"sid": "IGXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"account_sid": "ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"type": "sms",
+	"address": "+15555555555",
+	"friendly_name": "Support",
+	"address_country": "CA",
+	"auto_creation": {
+		"enabled": true,
+		"type": "webhook",
+		"conversation_service_sid": "ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+		"webhook_url": "https://example.com/webhooks",
+		"webhook_method": "POST",
+		"webhook_filters": ["onParticipantAdded", "onMessageAdded"],
+		"studio_flow_sid": null,
+		"studio_retry_count": null
+	},
+	"date_created": "2020-07-30T00:00:00Z",
+	"date_updated": "2020-07-30T00:00:00Z",
+	"url": "https://conversations.twilio.com/v1/Configuration/Addresses/IGXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}`
+
+const addressConfigurationsResponse = `{
+	"address_configurations": [` + addressConfigurationResponse + `],
+	"meta": {
+		"page": 0,
+		"page_size": 50,
+		"first_page_url": "https://conversations.twilio.com/v1/Configuration/Addresses?PageSize=50&Page=0",
+		"previous_page_url": null,
+		"url": "https://conversations.twilio.com/v1/Configuration/Addresses?PageSize=50&Page=0",
+		"next_page_url": null,
+		"key": "address_configurations"
+	}
+}`
+
+const addressAlreadyConfiguredResponse = `{
+	"code": 50427,
+	"message": "Address configuration already exists",
+	"more_info": "https://www.twilio.com/docs/errors/50427",
+	"status": 409
+}`
+
+func TestWillMakeRequestToCreateNewAddressConfigurationSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Configuration/Addresses"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		if req.Header.Get("Authorization") == "" {
+			t.Log("Missing authorization credentials, they should be supplied via the Authorization header")
+			t.Fail()
+		}
+
+		if req.Header.Get("Content-Type") != "application/x-www-form-urlencoded" {
+			t.Logf("Incorrect content-type header supplied, expecting [%s], but received [%s]", "application/x-www-form-urlencoded", req.Header.Get("Content-Type"))
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(addressConfigurationResponse)),
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+		}
+	}).WithConversationService("ISYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY")
+
+	response, err := twilio.CreateNewAddressConfiguration(twiligo.SMSAddress, "+15555555555", twiligo.AddressConfigurationOptions{})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if response.Type != twiligo.SMSAddress || response.AutoCreation.Type != twiligo.WebhookAutoCreation {
+		t.Logf("Incorrect types decoded, expecting [%s] and [%s], but received [%s] and [%s]", twiligo.SMSAddress, twiligo.WebhookAutoCreation, response.Type, response.AutoCreation.Type)
+		t.Fail()
+	}
+
+	if len(response.AutoCreation.WebhookFilters) != 2 {
+		t.Logf("Incorrect webhook filters decoded, received [%v]", response.AutoCreation.WebhookFilters)
+		t.Fail()
+	}
+}
+
+func TestWillIncludeProperRequestBodyParametersWhenMakingRequestToCreateNewAddressConfigurationSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		expected := map[string]string{
+			"Type":                                "sms",
+			"Address":                             "+15555555555",
+			"FriendlyName":                        "Support",
+			"AutoCreation.Enabled":                "true",
+			"AutoCreation.Type":                   "webhook",
+			"AutoCreation.ConversationServiceSid": "ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"AutoCreation.WebhookUrl":             "https://example.com/webhooks",
+			"AutoCreation.WebhookMethod":          "POST",
+		}
+
+		for key, value := range expected {
+			if params.Get(key) != value {
+				t.Logf("Incorrect request parameter supplied for %s, expecting [%s], but received [%s]", key, value, params.Get(key))
+				t.Fail()
+			}
+		}
+
+		filters := params["AutoCreation.WebhookFilters"]
+
+		if len(filters) != 2 || filters[0] != "onParticipantAdded" || filters[1] != "onMessageAdded" {
+			t.Logf("Incorrect webhook filters supplied, received [%v]", filters)
+			t.Fail()
+		}
+
+		if _, ok := params["AutoCreation.StudioFlowSid"]; ok {
+			t.Log("Empty request parameter AutoCreation.StudioFlowSid was incorrectly supplied")
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(addressConfigurationResponse)),
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+		}
+	})
+
+	enabled := true
+
+	twilio.CreateNewAddressConfigurationForIncomingPhoneNumber(&twiligo.IncomingPhoneNumber{
+		PhoneNumber:  "+15555555555",
+		FriendlyName: "Support",
+	}, twiligo.AddressAutoCreationOptions{
+		Enabled:                &enabled,
+		Type:                   twiligo.WebhookAutoCreation,
+		ConversationServiceSID: "ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+		WebhookURL:             "https://example.com/webhooks",
+		WebhookMethod:          http.MethodPost,
+		WebhookFilters:         []string{"onParticipantAdded", "onMessageAdded"},
+	})
+}
+
+func TestWillHandleErrorResponsesWhenMakingRequestToCreateNewAddressConfiguration(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(addressAlreadyConfiguredResponse)),
+			StatusCode: http.StatusConflict,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.CreateNewAddressConfiguration(twiligo.SMSAddress, "+15555555555", twiligo.AddressConfigurationOptions{})
+
+	if response != nil {
+		t.Logf("Response was incorrectly returned, was not expecting the following response: %v", response)
+		t.Fail()
+	}
+
+	expected := "Address configuration already exists"
+
+	if err == nil || err.Error() != expected {
+		t.Logf("Incorrect error returned, expected [%s], but received [%v]", expected, err)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToListAddressConfigurationsSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Configuration/Addresses"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		if req.URL.Query().Get("Type") != "sms" {
+			t.Logf("Incorrect query parameter supplied, expecting [%s], but received [%s]", "sms", req.URL.Query().Get("Type"))
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(addressConfigurationsResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.ListAddressConfigurations(twiligo.ListAddressConfigurationsOptions{Type: twiligo.SMSAddress})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if len(response.AddressConfigurations) != 1 || response.Meta.NextPage() != nil {
+		t.Logf("Incorrect address configurations decoded, received [%v]", response)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToUpdateAddressConfigurationSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Configuration/Addresses/IGXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+
+		if req.Method != http.MethodPost || req.URL.Path != expected {
+			t.Logf("Incorrect request supplied, expecting [POST %s], but received [%s %s]", expected, req.Method, req.URL.Path)
+			t.Fail()
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		if params.Get("AutoCreation.Enabled") != "false" {
+			t.Logf("Incorrect request parameter supplied, expecting [%s], but received [%s]", "false", params.Get("AutoCreation.Enabled"))
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(addressConfigurationResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	enabled := false
+
+	_, err := twilio.UpdateAddressConfiguration("IGXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.AddressConfigurationOptions{
+		AutoCreation: twiligo.AddressAutoCreationOptions{Enabled: &enabled},
+	})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToDeleteAddressConfigurationSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Configuration/Addresses/IGXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+
+		if req.Method != http.MethodDelete || req.URL.Path != expected {
+			t.Logf("Incorrect request supplied, expecting [DELETE %s], but received [%s %s]", expected, req.Method, req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(``)),
+			StatusCode: http.StatusNoContent,
+			Header:     make(http.Header),
+		}
+	})
+
+	err := twilio.DeleteAddressConfiguration("IGXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX")
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+}
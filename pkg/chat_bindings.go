@@ -0,0 +1,214 @@
+package twiligo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+// ChatBinding represents a single device registered by a chat user to receive push notifications from a chat service.
+type ChatBinding struct {
+	SID           string    `json:"sid"`
+	AccountSID    string    `json:"account_sid"`
+	ServiceSID    string    `json:"service_sid"`
+	CredentialSID string    `json:"credential_sid"`
+	UserSID       string    `json:"user_sid"`
+	Identity      string    `json:"identity"`
+	Endpoint      string    `json:"endpoint"`
+	BindingType   string    `json:"binding_type"`
+	MessageTypes  []string  `json:"message_types"`
+	DateCreated   time.Time `json:"date_created"`
+	DateUpdated   time.Time `json:"date_updated"`
+	Links         struct {
+		User string `json:"user"`
+	} `json:"links"`
+	URL string `json:"url"`
+}
+
+// ChatBindingsResponse is the representation of the JSON response from Twilio when listing push notification bindings.
+type ChatBindingsResponse struct {
+	Bindings []*ChatBinding `json:"bindings"`
+	Meta     Meta           `json:"meta"`
+}
+
+// ListChatBindingsOptions are all of the options that can be provided to a ListChatBindings call. BindingType can be any of apn, gcm or fcm.
+type ListChatBindingsOptions struct {
+	PageOptions
+	BindingType []string `url:",omitempty"`
+	Identity    []string `url:",omitempty"`
+}
+
+// ListChatUserBindingsOptions are all of the options that can be provided to a ListChatUserBindings call. BindingType can be any of apn, gcm or fcm.
+type ListChatUserBindingsOptions struct {
+	PageOptions
+	BindingType []string `url:",omitempty"`
+}
+
+// ListChatBindings retrieves a single page of the push notification bindings registered within the given chat service from Twilio.
+func (twilio *Twilio) ListChatBindings(serviceSID string, options ListChatBindingsOptions) (*ChatBindingsResponse, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.get(twilio.chatURL("Services/"+serviceSID+"/Bindings"), &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatBindingsResponse)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// FetchChatBinding retrieves the push notification binding matching the given identifier from the given chat service in Twilio.
+func (twilio *Twilio) FetchChatBinding(serviceSID, bindingSID string) (*ChatBinding, error) {
+	res, err := twilio.get(twilio.chatURL("Services/"+serviceSID+"/Bindings/"+bindingSID), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatBinding)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// DeleteChatBinding will remove the push notification binding matching the given identifier from the given chat service within Twilio.
+func (twilio *Twilio) DeleteChatBinding(serviceSID, bindingSID string) error {
+	res, err := twilio.delete(twilio.chatURL("Services/" + serviceSID + "/Bindings/" + bindingSID))
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		decoder := json.NewDecoder(res.Body)
+
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return err
+	}
+
+	return nil
+}
+
+// ListChatUserBindings retrieves a single page of the push notification bindings registered by the given chat user from Twilio.
+func (twilio *Twilio) ListChatUserBindings(serviceSID, userSID string, options ListChatUserBindingsOptions) (*ChatBindingsResponse, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.get(twilio.chatURL("Services/"+serviceSID+"/Users/"+url.PathEscape(userSID)+"/Bindings"), &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatBindingsResponse)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// FetchChatUserBinding retrieves the push notification binding matching the given identifier for the given chat user from Twilio.
+func (twilio *Twilio) FetchChatUserBinding(serviceSID, userSID, bindingSID string) (*ChatBinding, error) {
+	res, err := twilio.get(twilio.chatURL("Services/"+serviceSID+"/Users/"+url.PathEscape(userSID)+"/Bindings/"+bindingSID), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatBinding)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// DeleteChatUserBinding will remove the push notification binding matching the given identifier from the given chat user within Twilio.
+func (twilio *Twilio) DeleteChatUserBinding(serviceSID, userSID, bindingSID string) error {
+	res, err := twilio.delete(twilio.chatURL("Services/" + serviceSID + "/Users/" + url.PathEscape(userSID) + "/Bindings/" + bindingSID))
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		decoder := json.NewDecoder(res.Body)
+
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,68 @@
+package twiligo_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	twiligo "github.com/craigpaul/twiligo/pkg"
+)
+
+func TestWillMakeRequestToListChatBindingsSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Bindings"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		if req.URL.Query().Get("Identity") != "Unique Name" {
+			t.Logf("Incorrect query parameter supplied, expecting [%s], but received [%s]", "Unique Name", req.URL.Query().Get("Identity"))
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(chatUserBindingsResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.ListChatBindings("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.ListChatBindingsOptions{Identity: []string{"Unique Name"}})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if len(response.Bindings) != 1 || response.Bindings[0].CredentialSID != "CRXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX" {
+		t.Logf("Incorrect bindings decoded, received [%v]", response.Bindings)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToDeleteChatBindingSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Bindings/BSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+
+		if req.Method != http.MethodDelete || req.URL.Path != expected {
+			t.Logf("Incorrect request supplied, expecting [DELETE %s], but received [%s %s]", expected, req.Method, req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(``)),
+			StatusCode: http.StatusNoContent,
+			Header:     make(http.Header),
+		}
+	})
+
+	err := twilio.DeleteChatBinding("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "BSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX")
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+}
@@ -0,0 +1,242 @@
+package twiligo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+// This constant is used to represent whether a particular ChatChannel is visible to every user or only to its members.
+const (
+	PublicChatChannel ChatChannelType = iota + 1
+	PrivateChatChannel
+)
+
+// ChatChannel represents a single channel within a Twilio Chat service that members can join and exchange messages in.
+type ChatChannel struct {
+	SID           string          `json:"sid"`
+	AccountSID    string          `json:"account_sid"`
+	ServiceSID    string          `json:"service_sid"`
+	FriendlyName  *string         `json:"friendly_name"`
+	UniqueName    *string         `json:"unique_name"`
+	Attributes    string          `json:"attributes"`
+	Type          ChatChannelType `json:"type"`
+	CreatedBy     string          `json:"created_by"`
+	MembersCount  int             `json:"members_count"`
+	MessagesCount int             `json:"messages_count"`
+	DateCreated   time.Time       `json:"date_created"`
+	DateUpdated   time.Time       `json:"date_updated"`
+	Links         struct {
+		Members     string `json:"members"`
+		Messages    string `json:"messages"`
+		Invites     string `json:"invites"`
+		Webhooks    string `json:"webhooks"`
+		LastMessage string `json:"last_message"`
+	} `json:"links"`
+	URL string `json:"url"`
+}
+
+// ChatChannelOptions are all of the options that can be provided to a CreateNewChatChannel or UpdateChatChannel call. Note: Type can only be provided when creating a new channel.
+type ChatChannelOptions struct {
+	FriendlyName string          `url:",omitempty"`
+	UniqueName   string          `url:",omitempty"`
+	Attributes   string          `url:",omitempty"`
+	Type         ChatChannelType `url:",omitempty"`
+	CreatedBy    string          `url:",omitempty"`
+	DateCreated  time.Time       `url:",omitempty"`
+	DateUpdated  time.Time       `url:",omitempty"`
+}
+
+// ChatChannelType is used to define whether a particular ChatChannel is public or private.
+type ChatChannelType int
+
+// ChatChannelsResponse is the representation of the JSON response from Twilio when listing the channels of a chat service.
+type ChatChannelsResponse struct {
+	Channels []*ChatChannel `json:"channels"`
+	Meta     Meta           `json:"meta"`
+}
+
+// ListChatChannelsOptions are all of the options that can be provided to a ListChatChannels call.
+type ListChatChannelsOptions struct {
+	PageOptions
+	Type []ChatChannelType `url:",omitempty"`
+}
+
+// CreateNewChatChannel creates a new channel for the given chat service in Twilio.
+func (twilio *Twilio) CreateNewChatChannel(serviceSID string, options ChatChannelOptions) (*ChatChannel, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.chatURL("Services/"+serviceSID+"/Channels"), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusCreated {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatChannel)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// FetchChatChannel retrieves the channel matching the given identifier, which may be either the SID or the UniqueName of the channel, from the given chat service in Twilio.
+func (twilio *Twilio) FetchChatChannel(serviceSID, channelSID string) (*ChatChannel, error) {
+	res, err := twilio.get(twilio.chatURL("Services/"+serviceSID+"/Channels/"+url.PathEscape(channelSID)), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatChannel)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// ListChatChannels retrieves a single page of the channels within the given chat service from Twilio.
+func (twilio *Twilio) ListChatChannels(serviceSID string, options ListChatChannelsOptions) (*ChatChannelsResponse, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.get(twilio.chatURL("Services/"+serviceSID+"/Channels"), &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatChannelsResponse)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// UpdateChatChannel will update an existing channel, identified by either its SID or UniqueName, within the given chat service in Twilio based on the provided options.
+func (twilio *Twilio) UpdateChatChannel(serviceSID, channelSID string, options ChatChannelOptions) (*ChatChannel, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.chatURL("Services/"+serviceSID+"/Channels/"+url.PathEscape(channelSID)), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatChannel)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// DeleteChatChannel will completely remove the channel, identified by either its SID or UniqueName, from the given chat service within Twilio.
+func (twilio *Twilio) DeleteChatChannel(serviceSID, channelSID string) error {
+	res, err := twilio.delete(twilio.chatURL("Services/" + serviceSID + "/Channels/" + url.PathEscape(channelSID)))
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		decoder := json.NewDecoder(res.Body)
+
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON handles converting a ChatChannelType into the string representation used by Twilio.
+func (channelType ChatChannelType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(channelType.String())
+}
+
+// UnmarshalJSON handles converting the string representation used by Twilio into a ChatChannelType.
+func (channelType *ChatChannelType) UnmarshalJSON(b []byte) error {
+	var s string
+
+	err := json.Unmarshal(b, &s)
+
+	if err != nil {
+		return err
+	}
+
+	*channelType = ConvertTypeToChatChannelType(s)
+
+	return nil
+}
+
+func (channelType ChatChannelType) String() string {
+	return map[ChatChannelType]string{
+		PublicChatChannel:  "public",
+		PrivateChatChannel: "private",
+	}[channelType]
+}
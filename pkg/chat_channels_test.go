@@ -0,0 +1,226 @@
+package twiligo_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+
+	twiligo "github.com/craigpaul/twiligo/pkg"
+)
+
+const chatChannelResponse = `{
+	"sid": "CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"account_sid": "ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"service_sid": "ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"friendly_name": "General",
+	"unique_name": "general",
+	"attributes": "{}",
+	"type": "private",
+	"created_by": "system",
+	"members_count": 0,
+	"messages_count": 0,
+	"date_created": "2020-07-30T00:00:00Z",
+	"date_updated": "2020-07-30T00:00:00Z",
+	"links": {
+		"members": "https://chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Members",
+		"messages": "https://chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Messages",
+		"invites": "https://chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Invites",
+		"webhooks": "https://chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Webhooks",
+		"last_message": null
+	},
+	"url": "https://chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}`
+
+const chatChannelsResponse = `{
+	"channels": [` + chatChannelResponse + `],
+	"meta": {
+		"page": 0,
+		"page_size": 50,
+		"first_page_url": "https://chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels?PageSize=50&Page=0",
+		"previous_page_url": null,
+		"url": "https://chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels?PageSize=50&Page=0",
+		"next_page_url": null,
+		"key": "channels"
+	}
+}`
+
+const chatChannelNotFoundResponse = `{
+	"code": 20404,
+	"message": "The requested resource /Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels/general was not found",
+	"more_info": "https://www.twilio.com/docs/errors/20404",
+	"status": 404
+}`
+
+func TestWillMakeRequestToCreateNewChatChannelSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		if req.Header.Get("Authorization") == "" {
+			t.Log("Missing authorization credentials, they should be supplied via the Authorization header")
+			t.Fail()
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		if params.Get("Type") != "private" || params.Get("UniqueName") != "general" || params.Get("CreatedBy") != "system" {
+			t.Logf("Incorrect request parameters supplied, received [%v]", params)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(chatChannelResponse)),
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.CreateNewChatChannel("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.ChatChannelOptions{
+		FriendlyName: "General",
+		UniqueName:   "general",
+		Type:         twiligo.PrivateChatChannel,
+		CreatedBy:    "system",
+	})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if response.Type != twiligo.PrivateChatChannel {
+		t.Logf("Incorrect channel type decoded, expecting [%s], but received [%s]", twiligo.PrivateChatChannel, response.Type)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToFetchChatChannelByUniqueNameSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels/support%2Fbilling"
+
+		if req.URL.EscapedPath() != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.EscapedPath())
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(chatChannelResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	_, err := twilio.FetchChatChannel("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "support/billing")
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+}
+
+func TestWillHandleErrorResponsesWhenMakingRequestToFetchChatChannel(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(chatChannelNotFoundResponse)),
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.FetchChatChannel("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "general")
+
+	if response != nil {
+		t.Logf("Response was incorrectly returned, was not expecting the following response: %v", response)
+		t.Fail()
+	}
+
+	if exception, ok := err.(*twiligo.Exception); ok == false || exception.Status != http.StatusNotFound {
+		t.Logf("Incorrect error returned, expected a not found exception, but received [%v]", err)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToListChatChannelsSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		types := req.URL.Query()["Type"]
+
+		if len(types) != 2 || types[0] != "public" || types[1] != "private" {
+			t.Logf("Incorrect query parameters supplied, received [%v]", types)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(chatChannelsResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.ListChatChannels("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.ListChatChannelsOptions{
+		Type: []twiligo.ChatChannelType{twiligo.PublicChatChannel, twiligo.PrivateChatChannel},
+	})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if len(response.Channels) != 1 || response.Meta.Key != "channels" {
+		t.Logf("Incorrect channels decoded, received [%v]", response)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToUpdateChatChannelSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+
+		if req.Method != http.MethodPost || req.URL.Path != expected {
+			t.Logf("Incorrect request supplied, expecting [POST %s], but received [%s %s]", expected, req.Method, req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(chatChannelResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	_, err := twilio.UpdateChatChannel("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.ChatChannelOptions{Attributes: `{"topic": "billing"}`})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToDeleteChatChannelSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels/general"
+
+		if req.Method != http.MethodDelete || req.URL.Path != expected {
+			t.Logf("Incorrect request supplied, expecting [DELETE %s], but received [%s %s]", expected, req.Method, req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(``)),
+			StatusCode: http.StatusNoContent,
+			Header:     make(http.Header),
+		}
+	})
+
+	err := twilio.DeleteChatChannel("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "general")
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+}
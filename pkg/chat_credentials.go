@@ -0,0 +1,244 @@
+package twiligo
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+// This constant is used to represent the push notification provider that a ChatCredential authenticates against.
+const (
+	APNChatCredential ChatCredentialType = iota + 1
+	FCMChatCredential
+	GCMChatCredential
+)
+
+// ChatCredential represents the push notification credentials used by Twilio to deliver notifications to a chat user's device.
+type ChatCredential struct {
+	SID          string             `json:"sid"`
+	AccountSID   string             `json:"account_sid"`
+	FriendlyName *string            `json:"friendly_name"`
+	Type         ChatCredentialType `json:"type"`
+	Sandbox      *string            `json:"sandbox"`
+	DateCreated  time.Time          `json:"date_created"`
+	DateUpdated  time.Time          `json:"date_updated"`
+	URL          string             `json:"url"`
+}
+
+// ChatCredentialOptions are all of the options that can be provided to a CreateNewChatCredential or UpdateChatCredential call. Certificate and PrivateKey are the PEM encoded contents of an APN certificate and key, Secret is the FCM server key and APIKey is the GCM API key.
+type ChatCredentialOptions struct {
+	FriendlyName string `url:",omitempty"`
+	Certificate  string `url:",omitempty"`
+	PrivateKey   string `url:",omitempty"`
+	Sandbox      *bool  `url:",omitempty"`
+	APIKey       string `url:"ApiKey,omitempty"`
+	Secret       string `url:",omitempty"`
+}
+
+// ChatCredentialsResponse is the representation of the JSON response from Twilio when listing push notification credentials.
+type ChatCredentialsResponse struct {
+	Credentials []*ChatCredential `json:"credentials"`
+	Meta        Meta              `json:"meta"`
+}
+
+// ChatCredentialType is used to define the push notification provider that a ChatCredential authenticates against.
+type ChatCredentialType int
+
+type createNewChatCredentialOptions struct {
+	ChatCredentialOptions
+	Type ChatCredentialType
+}
+
+// CreateNewChatCredential creates a new set of push notification credentials in Twilio for the given provider. APN credentials require both a Certificate and PrivateKey, FCM credentials require a Secret and GCM credentials require an APIKey.
+func (twilio *Twilio) CreateNewChatCredential(credentialType ChatCredentialType, options ChatCredentialOptions) (*ChatCredential, error) {
+	switch {
+	case credentialType == APNChatCredential && options.Certificate == "":
+		return nil, errors.New("Missing required parameter Certificate")
+	case credentialType == APNChatCredential && options.PrivateKey == "":
+		return nil, errors.New("Missing required parameter PrivateKey")
+	case credentialType == FCMChatCredential && options.Secret == "":
+		return nil, errors.New("Missing required parameter Secret")
+	case credentialType == GCMChatCredential && options.APIKey == "":
+		return nil, errors.New("Missing required parameter ApiKey")
+	}
+
+	params, err := query.Values(createNewChatCredentialOptions{
+		ChatCredentialOptions: options,
+		Type:                  credentialType,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.chatURL("Credentials"), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusCreated {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatCredential)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// FetchChatCredential retrieves the push notification credentials matching the given identifier from Twilio.
+func (twilio *Twilio) FetchChatCredential(credentialSID string) (*ChatCredential, error) {
+	res, err := twilio.get(twilio.chatURL("Credentials/"+credentialSID), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatCredential)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// ListChatCredentials retrieves a single page of push notification credentials from Twilio.
+func (twilio *Twilio) ListChatCredentials(options PageOptions) (*ChatCredentialsResponse, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.get(twilio.chatURL("Credentials"), &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatCredentialsResponse)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// UpdateChatCredential will update an existing set of push notification credentials in Twilio, such as when rotating an expiring APN certificate.
+func (twilio *Twilio) UpdateChatCredential(credentialSID string, options ChatCredentialOptions) (*ChatCredential, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.chatURL("Credentials/"+credentialSID), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatCredential)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// DeleteChatCredential will completely remove the push notification credentials matching the given identifier from within Twilio.
+func (twilio *Twilio) DeleteChatCredential(credentialSID string) error {
+	res, err := twilio.delete(twilio.chatURL("Credentials/" + credentialSID))
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		decoder := json.NewDecoder(res.Body)
+
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON handles converting a ChatCredentialType into the string representation used by Twilio.
+func (credentialType ChatCredentialType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(credentialType.String())
+}
+
+// UnmarshalJSON handles converting the string representation used by Twilio into a ChatCredentialType.
+func (credentialType *ChatCredentialType) UnmarshalJSON(b []byte) error {
+	var s string
+
+	err := json.Unmarshal(b, &s)
+
+	if err != nil {
+		return err
+	}
+
+	*credentialType = ConvertTypeToChatCredentialType(s)
+
+	return nil
+}
+
+func (credentialType ChatCredentialType) String() string {
+	return map[ChatCredentialType]string{
+		APNChatCredential: "apn",
+		FCMChatCredential: "fcm",
+		GCMChatCredential: "gcm",
+	}[credentialType]
+}
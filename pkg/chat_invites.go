@@ -0,0 +1,157 @@
+package twiligo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+// ChatInvite represents an invitation for a chat user to join a ChatChannel.
+type ChatInvite struct {
+	SID         string    `json:"sid"`
+	AccountSID  string    `json:"account_sid"`
+	ServiceSID  string    `json:"service_sid"`
+	ChannelSID  string    `json:"channel_sid"`
+	Identity    string    `json:"identity"`
+	RoleSID     *string   `json:"role_sid"`
+	CreatedBy   *string   `json:"created_by"`
+	DateCreated time.Time `json:"date_created"`
+	DateUpdated time.Time `json:"date_updated"`
+	URL         string    `json:"url"`
+}
+
+// ChatInvitesResponse is the representation of the JSON response from Twilio when listing the invites of a channel.
+type ChatInvitesResponse struct {
+	Invites []*ChatInvite `json:"invites"`
+	Meta    Meta          `json:"meta"`
+}
+
+// ListChatInvitesOptions are all of the options that can be provided to a ListChatInvites call.
+type ListChatInvitesOptions struct {
+	PageOptions
+	Identity []string `url:",omitempty"`
+}
+
+type createNewChatInviteOptions struct {
+	Identity string
+	RoleSID  string `url:"RoleSid,omitempty"`
+}
+
+// CreateNewChatInvite invites the chat user matching the given identity to join the given channel in Twilio. The role is optional and falls back to the default channel role of the service when left empty.
+func (twilio *Twilio) CreateNewChatInvite(serviceSID, channelSID, identity, roleSID string) (*ChatInvite, error) {
+	params, err := query.Values(createNewChatInviteOptions{Identity: identity, RoleSID: roleSID})
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.chatURL("Services/"+serviceSID+"/Channels/"+url.PathEscape(channelSID)+"/Invites"), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusCreated {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatInvite)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// FetchChatInvite retrieves the invite matching the given identifier from the given channel in Twilio.
+func (twilio *Twilio) FetchChatInvite(serviceSID, channelSID, inviteSID string) (*ChatInvite, error) {
+	res, err := twilio.get(twilio.chatURL("Services/"+serviceSID+"/Channels/"+url.PathEscape(channelSID)+"/Invites/"+inviteSID), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatInvite)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// ListChatInvites retrieves a single page of the invites within the given channel from Twilio.
+func (twilio *Twilio) ListChatInvites(serviceSID, channelSID string, options ListChatInvitesOptions) (*ChatInvitesResponse, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.get(twilio.chatURL("Services/"+serviceSID+"/Channels/"+url.PathEscape(channelSID)+"/Invites"), &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatInvitesResponse)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// DeleteChatInvite will remove the invite matching the given identifier from the given channel within Twilio.
+func (twilio *Twilio) DeleteChatInvite(serviceSID, channelSID, inviteSID string) error {
+	res, err := twilio.delete(twilio.chatURL("Services/" + serviceSID + "/Channels/" + url.PathEscape(channelSID) + "/Invites/" + inviteSID))
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		decoder := json.NewDecoder(res.Body)
+
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,88 @@
+package twiligo_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+const chatInviteResponse = `{
+	"sid": "INXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"account_sid": "ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"service_sid": "ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"channel_sid": "CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"identity": "jing",
+	"role_sid": null,
+	"created_by": "system",
+	"date_created": "2020-07-30T00:00:00Z",
+	"date_updated": "2020-07-30T00:00:00Z",
+	"url": "https://chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Invites/INXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}`
+
+func TestWillMakeRequestToCreateNewChatInviteSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Invites"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		if params.Get("Identity") != "jing" {
+			t.Logf("Incorrect request parameter supplied, expecting [%s], but received [%s]", "jing", params.Get("Identity"))
+			t.Fail()
+		}
+
+		if _, ok := params["RoleSid"]; ok {
+			t.Log("Empty request parameter RoleSid was incorrectly supplied")
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(chatInviteResponse)),
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.CreateNewChatInvite("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "jing", "")
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if response.SID != "INXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX" || response.RoleSID != nil {
+		t.Logf("Incorrect invite decoded, received [%v]", response)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToDeleteChatInviteSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Invites/INXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+
+		if req.Method != http.MethodDelete || req.URL.Path != expected {
+			t.Logf("Incorrect request supplied, expecting [DELETE %s], but received [%s %s]", expected, req.Method, req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(``)),
+			StatusCode: http.StatusNoContent,
+			Header:     make(http.Header),
+		}
+	})
+
+	err := twilio.DeleteChatInvite("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "INXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX")
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+}
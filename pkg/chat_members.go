@@ -0,0 +1,199 @@
+package twiligo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+// ChatMember represents a single chat user that has joined a ChatChannel.
+type ChatMember struct {
+	SID                      string     `json:"sid"`
+	AccountSID               string     `json:"account_sid"`
+	ServiceSID               string     `json:"service_sid"`
+	ChannelSID               string     `json:"channel_sid"`
+	Identity                 string     `json:"identity"`
+	RoleSID                  string     `json:"role_sid"`
+	Attributes               string     `json:"attributes"`
+	LastConsumedMessageIndex *int       `json:"last_consumed_message_index"`
+	LastConsumptionTimestamp *time.Time `json:"last_consumption_timestamp"`
+	DateCreated              time.Time  `json:"date_created"`
+	DateUpdated              time.Time  `json:"date_updated"`
+	URL                      string     `json:"url"`
+}
+
+// ChatMemberOptions are all of the options that can be provided to a CreateNewChatMember or UpdateChatMember call.
+type ChatMemberOptions struct {
+	RoleSID                  string    `url:"RoleSid,omitempty"`
+	Attributes               string    `url:",omitempty"`
+	LastConsumedMessageIndex *int      `url:",omitempty"`
+	LastConsumptionTimestamp time.Time `url:",omitempty"`
+	DateCreated              time.Time `url:",omitempty"`
+	DateUpdated              time.Time `url:",omitempty"`
+}
+
+// ChatMembersResponse is the representation of the JSON response from Twilio when listing the members of a channel.
+type ChatMembersResponse struct {
+	Members []*ChatMember `json:"members"`
+	Meta    Meta          `json:"meta"`
+}
+
+// ListChatMembersOptions are all of the options that can be provided to a ListChatMembers call.
+type ListChatMembersOptions struct {
+	PageOptions
+	Identity []string `url:",omitempty"`
+}
+
+// CreateNewChatMember adds the chat user matching the given identity to the given channel in Twilio.
+func (twilio *Twilio) CreateNewChatMember(serviceSID, channelSID, identity string, options ChatMemberOptions) (*ChatMember, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	params.Add("Identity", identity)
+
+	res, err := twilio.post(twilio.chatURL("Services/"+serviceSID+"/Channels/"+url.PathEscape(channelSID)+"/Members"), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusCreated {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatMember)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// FetchChatMember retrieves the member matching the given identifier, which may be either the SID or the Identity of the member, from the given channel in Twilio.
+func (twilio *Twilio) FetchChatMember(serviceSID, channelSID, memberSID string) (*ChatMember, error) {
+	res, err := twilio.get(twilio.chatURL("Services/"+serviceSID+"/Channels/"+url.PathEscape(channelSID)+"/Members/"+url.PathEscape(memberSID)), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatMember)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// ListChatMembers retrieves a single page of the members within the given channel from Twilio.
+func (twilio *Twilio) ListChatMembers(serviceSID, channelSID string, options ListChatMembersOptions) (*ChatMembersResponse, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.get(twilio.chatURL("Services/"+serviceSID+"/Channels/"+url.PathEscape(channelSID)+"/Members"), &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatMembersResponse)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// UpdateChatMember will update an existing member, identified by either its SID or Identity, within the given channel in Twilio based on the provided options. This is typically used to advance the LastConsumedMessageIndex of a member as they read messages.
+func (twilio *Twilio) UpdateChatMember(serviceSID, channelSID, memberSID string, options ChatMemberOptions) (*ChatMember, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.chatURL("Services/"+serviceSID+"/Channels/"+url.PathEscape(channelSID)+"/Members/"+url.PathEscape(memberSID)), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatMember)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// DeleteChatMember will remove the member, identified by either its SID or Identity, from the given channel within Twilio.
+func (twilio *Twilio) DeleteChatMember(serviceSID, channelSID, memberSID string) error {
+	res, err := twilio.delete(twilio.chatURL("Services/" + serviceSID + "/Channels/" + url.PathEscape(channelSID) + "/Members/" + url.PathEscape(memberSID)))
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		decoder := json.NewDecoder(res.Body)
+
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return err
+	}
+
+	return nil
+}
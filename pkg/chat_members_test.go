@@ -0,0 +1,159 @@
+package twiligo_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+
+	twiligo "github.com/craigpaul/twiligo/pkg"
+)
+
+const chatMemberResponse = `{
+	"sid": "MBXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"account_sid": "ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"service_sid": "ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"channel_sid": "CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"identity": "jing",
+	"role_sid": "RLXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"attributes": "{}",
+	"last_consumed_message_index": 0,
+	"last_consumption_timestamp": "2020-07-30T00:00:00Z",
+	"date_created": "2020-07-30T00:00:00Z",
+	"date_updated": "2020-07-30T00:00:00Z",
+	"url": "https://chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Members/MBXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}`
+
+const chatMembersResponse = `{
+	"members": [` + chatMemberResponse + `],
+	"meta": {"page": 0, "page_size": 50, "next_page_url": null, "key": "members"}
+}`
+
+const memberAlreadyExistsResponse = `{
+	"code": 50404,
+	"message": "Member already exists",
+	"more_info": "https://www.twilio.com/docs/errors/50404",
+	"status": 409
+}`
+
+func TestWillMakeRequestToCreateNewChatMemberSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Members"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		if params.Get("Identity") != "jing" {
+			t.Logf("Incorrect request parameter supplied, expecting [%s], but received [%s]", "jing", params.Get("Identity"))
+			t.Fail()
+		}
+
+		if params.Get("LastConsumedMessageIndex") != "0" {
+			t.Logf("Incorrect request parameter supplied, expecting [%s], but received [%s]", "0", params.Get("LastConsumedMessageIndex"))
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(chatMemberResponse)),
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+		}
+	})
+
+	index := 0
+
+	response, err := twilio.CreateNewChatMember("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "jing", twiligo.ChatMemberOptions{
+		LastConsumedMessageIndex: &index,
+	})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if response.LastConsumedMessageIndex == nil || *response.LastConsumedMessageIndex != 0 {
+		t.Logf("Incorrect last consumed message index decoded, received [%v]", response.LastConsumedMessageIndex)
+		t.Fail()
+	}
+}
+
+func TestWillHandleErrorResponsesWhenMakingRequestToCreateNewChatMember(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(memberAlreadyExistsResponse)),
+			StatusCode: http.StatusConflict,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.CreateNewChatMember("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "jing", twiligo.ChatMemberOptions{})
+
+	if response != nil {
+		t.Logf("Response was incorrectly returned, was not expecting the following response: %v", response)
+		t.Fail()
+	}
+
+	expected := "Member already exists"
+
+	if err == nil || err.Error() != expected {
+		t.Logf("Incorrect error returned, expected [%s], but received [%v]", expected, err)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToListChatMembersSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		if req.URL.Query().Get("Identity") != "jing" {
+			t.Logf("Incorrect query parameter supplied, expecting [%s], but received [%s]", "jing", req.URL.Query().Get("Identity"))
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(chatMembersResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.ListChatMembers("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.ListChatMembersOptions{Identity: []string{"jing"}})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if len(response.Members) != 1 {
+		t.Logf("Incorrect members decoded, received [%v]", response.Members)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToDeleteChatMemberByIdentitySuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels/general/Members/jing"
+
+		if req.Method != http.MethodDelete || req.URL.Path != expected {
+			t.Logf("Incorrect request supplied, expecting [DELETE %s], but received [%s %s]", expected, req.Method, req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(``)),
+			StatusCode: http.StatusNoContent,
+			Header:     make(http.Header),
+		}
+	})
+
+	err := twilio.DeleteChatMember("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "general", "jing")
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+}
@@ -0,0 +1,221 @@
+package twiligo
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+// ChatMessage represents a single message, either text or media, sent within a ChatChannel.
+type ChatMessage struct {
+	SID           string    `json:"sid"`
+	AccountSID    string    `json:"account_sid"`
+	ServiceSID    string    `json:"service_sid"`
+	ChannelSID    string    `json:"channel_sid"`
+	To            string    `json:"to"`
+	From          string    `json:"from"`
+	Body          string    `json:"body"`
+	Index         int       `json:"index"`
+	Type          string    `json:"type"`
+	Attributes    string    `json:"attributes"`
+	LastUpdatedBy *string   `json:"last_updated_by"`
+	WasEdited     bool      `json:"was_edited"`
+	DateCreated   time.Time `json:"date_created"`
+	DateUpdated   time.Time `json:"date_updated"`
+	Media         *struct {
+		SID         string  `json:"sid"`
+		ContentType string  `json:"content_type"`
+		Filename    *string `json:"filename"`
+		Size        int     `json:"size"`
+	} `json:"media"`
+	URL string `json:"url"`
+}
+
+// ChatMessageOptions are all of the options that can be provided to a CreateNewChatMessage or UpdateChatMessage call. Note: MediaSID can only be provided when creating a new message.
+type ChatMessageOptions struct {
+	From          string    `url:",omitempty"`
+	Body          string    `url:",omitempty"`
+	MediaSID      string    `url:"MediaSid,omitempty"`
+	Attributes    string    `url:",omitempty"`
+	LastUpdatedBy string    `url:",omitempty"`
+	DateCreated   time.Time `url:",omitempty"`
+	DateUpdated   time.Time `url:",omitempty"`
+}
+
+// ChatMessagesResponse is the representation of the JSON response from Twilio when listing the messages of a channel.
+type ChatMessagesResponse struct {
+	Messages []*ChatMessage `json:"messages"`
+	Meta     Meta           `json:"meta"`
+}
+
+// ListChatMessagesOptions are all of the options that can be provided to a ListChatMessages call. Order can be either asc (the default) or desc.
+type ListChatMessagesOptions struct {
+	PageOptions
+	Order string `url:",omitempty"`
+}
+
+// CreateNewChatMessage sends a new message to the given channel in Twilio.
+func (twilio *Twilio) CreateNewChatMessage(serviceSID, channelSID string, options ChatMessageOptions) (*ChatMessage, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.chatURL("Services/"+serviceSID+"/Channels/"+url.PathEscape(channelSID)+"/Messages"), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusCreated {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatMessage)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// CreateNewChatMediaMessage uploads the contents of the given reader to the Media Content Service of the given chat service and then sends a new message with that media attached to the given channel in Twilio.
+func (twilio *Twilio) CreateNewChatMediaMessage(serviceSID, channelSID, contentType, filename string, media io.Reader, options ChatMessageOptions) (*ChatMessage, error) {
+	uploaded, err := twilio.uploadConversationMedia(serviceSID, contentType, filename, media)
+
+	if err != nil {
+		return nil, err
+	}
+
+	options.MediaSID = uploaded.SID
+
+	return twilio.CreateNewChatMessage(serviceSID, channelSID, options)
+}
+
+// FetchChatMessage retrieves the message matching the given identifier from the given channel in Twilio.
+func (twilio *Twilio) FetchChatMessage(serviceSID, channelSID, messageSID string) (*ChatMessage, error) {
+	res, err := twilio.get(twilio.chatURL("Services/"+serviceSID+"/Channels/"+url.PathEscape(channelSID)+"/Messages/"+messageSID), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatMessage)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// ListChatMessages retrieves a single page of the messages within the given channel from Twilio.
+func (twilio *Twilio) ListChatMessages(serviceSID, channelSID string, options ListChatMessagesOptions) (*ChatMessagesResponse, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.get(twilio.chatURL("Services/"+serviceSID+"/Channels/"+url.PathEscape(channelSID)+"/Messages"), &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatMessagesResponse)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// UpdateChatMessage will update an existing message within the given channel in Twilio based on the provided identifier and options.
+func (twilio *Twilio) UpdateChatMessage(serviceSID, channelSID, messageSID string, options ChatMessageOptions) (*ChatMessage, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.chatURL("Services/"+serviceSID+"/Channels/"+url.PathEscape(channelSID)+"/Messages/"+messageSID), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatMessage)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// DeleteChatMessage will remove the message matching the given identifier from the given channel within Twilio.
+func (twilio *Twilio) DeleteChatMessage(serviceSID, channelSID, messageSID string) error {
+	res, err := twilio.delete(twilio.chatURL("Services/" + serviceSID + "/Channels/" + url.PathEscape(channelSID) + "/Messages/" + messageSID))
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		decoder := json.NewDecoder(res.Body)
+
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,170 @@
+package twiligo_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	twiligo "github.com/craigpaul/twiligo/pkg"
+)
+
+const chatMessageResponse = `{
+	"sid": "IMXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"account_sid": "ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"service_sid": "ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"channel_sid": "CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"to": "CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"from": "system",
+	"body": "Hello",
+	"index": 0,
+	"type": "text",
+	"attributes": "{}",
+	"last_updated_by": null,
+	"was_edited": false,
+	"media": null,
+	"date_created": "2020-07-30T00:00:00Z",
+	"date_updated": "2020-07-30T00:00:00Z",
+	"url": "https://chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Messages/IMXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}`
+
+func TestWillMakeRequestToCreateNewChatMessageSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Messages"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		if params.Get("Body") != "Hello" || params.Get("From") != "system" || params.Get("Attributes") != "{}" {
+			t.Logf("Incorrect request parameters supplied, received [%v]", params)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(chatMessageResponse)),
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.CreateNewChatMessage("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.ChatMessageOptions{
+		From:       "system",
+		Body:       "Hello",
+		Attributes: "{}",
+	})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if response.Body != "Hello" || response.Media != nil {
+		t.Logf("Incorrect message decoded, received [%v]", response)
+		t.Fail()
+	}
+}
+
+func TestWillUploadMediaToChatServiceBeforeCreatingNewChatMediaMessage(t *testing.T) {
+	requests := []string{}
+
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		requests = append(requests, req.URL.Host+req.URL.Path)
+
+		if req.URL.Host == "mcs.us1.twilio.com" {
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(conversationMediaResponse)),
+				StatusCode: http.StatusCreated,
+				Header:     make(http.Header),
+			}
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		if params.Get("MediaSid") != "MEXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX" {
+			t.Logf("Incorrect request parameter supplied, expecting [%s], but received [%s]", "MEXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", params.Get("MediaSid"))
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(chatMessageResponse)),
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+		}
+	})
+
+	_, err := twilio.CreateNewChatMediaMessage("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "image/png", "receipt.png", strings.NewReader("\x89PNG"), twiligo.ChatMessageOptions{})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	expected := []string{
+		"mcs.us1.twilio.com/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Media",
+		"chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Messages",
+	}
+
+	if strings.Join(requests, ",") != strings.Join(expected, ",") {
+		t.Logf("Incorrect requests made, expected [%v], but received [%v]", expected, requests)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToListChatMessagesSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		if req.URL.Query().Get("Order") != "desc" {
+			t.Logf("Incorrect query parameter supplied, expecting [%s], but received [%s]", "desc", req.URL.Query().Get("Order"))
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"messages": [` + chatMessageResponse + `], "meta": {"page": 0, "page_size": 50, "key": "messages"}}`)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.ListChatMessages("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.ListChatMessagesOptions{Order: "desc"})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if len(response.Messages) != 1 || response.Meta.NextPage() != nil {
+		t.Logf("Incorrect messages decoded, received [%v]", response)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToDeleteChatMessageSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Messages/IMXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+
+		if req.Method != http.MethodDelete || req.URL.Path != expected {
+			t.Logf("Incorrect request supplied, expecting [DELETE %s], but received [%s %s]", expected, req.Method, req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(``)),
+			StatusCode: http.StatusNoContent,
+			Header:     make(http.Header),
+		}
+	})
+
+	err := twilio.DeleteChatMessage("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "IMXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX")
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+}
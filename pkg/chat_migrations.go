@@ -0,0 +1,297 @@
+package twiligo
+
+import (
+	"errors"
+)
+
+// ChatMigrationOptions are all of the options that can be provided to a MigrateChatService call.
+type ChatMigrationOptions struct {
+	// DryRun reads everything that would be migrated and reports it without creating anything in Conversations. Every mapped SID in the report is left empty.
+	DryRun bool
+}
+
+// ChatMigrationReport maps the SID of every migrated chat resource to the SID of the Conversations resource that was created in its place: users to conversation users, channels to conversations, members to participants and messages to conversation messages.
+type ChatMigrationReport struct {
+	DryRun   bool              `json:"dry_run"`
+	Users    map[string]string `json:"users"`
+	Channels map[string]string `json:"channels"`
+	Members  map[string]string `json:"members"`
+	Messages map[string]string `json:"messages"`
+}
+
+type chatMigration struct {
+	twilio     *Twilio
+	serviceSID string
+	report     *ChatMigrationReport
+}
+
+// MigrateChatService copies the users, channels, members and messages of the given chat service into the Conversation Service the current instance is directed at (see WithConversationService).
+func (twilio *Twilio) MigrateChatService(serviceSID string, options ChatMigrationOptions) (*ChatMigrationReport, error) {
+	migration := &chatMigration{
+		twilio:     twilio,
+		serviceSID: serviceSID,
+		report: &ChatMigrationReport{
+			DryRun:   options.DryRun,
+			Users:    map[string]string{},
+			Channels: map[string]string{},
+			Members:  map[string]string{},
+			Messages: map[string]string{},
+		},
+	}
+
+	// Media can only be uploaded to a Conversation Service, so make sure none is needed before anything is created.
+	if twilio.ConversationServiceSID == "" && options.DryRun == false {
+		_, err := twilio.MigrateChatService(serviceSID, ChatMigrationOptions{DryRun: true})
+
+		if err != nil {
+			return migration.report, err
+		}
+	}
+
+	err := migration.migrateUsers()
+
+	if err != nil {
+		return migration.report, err
+	}
+
+	err = migration.migrateChannels()
+
+	if err != nil {
+		return migration.report, err
+	}
+
+	return migration.report, nil
+}
+
+func (migration *chatMigration) migrateUsers() error {
+	options := PageOptions{}
+
+	for {
+		response, err := migration.twilio.ListChatUsers(migration.serviceSID, options)
+
+		if err != nil {
+			return err
+		}
+
+		for _, user := range response.Users {
+			migration.report.Users[user.SID] = ""
+
+			if migration.report.DryRun {
+				continue
+			}
+
+			userOptions := ConversationUserOptions{Attributes: user.Attributes}
+
+			if user.FriendlyName != nil {
+				userOptions.FriendlyName = *user.FriendlyName
+			}
+
+			created, err := migration.twilio.CreateNewConversationUser(user.Identity, userOptions)
+
+			if err != nil {
+				return err
+			}
+
+			migration.report.Users[user.SID] = created.SID
+		}
+
+		next := response.Meta.NextPage()
+
+		if next == nil {
+			return nil
+		}
+
+		options = *next
+	}
+}
+
+func (migration *chatMigration) migrateChannels() error {
+	options := ListChatChannelsOptions{Type: []ChatChannelType{PublicChatChannel, PrivateChatChannel}}
+
+	for {
+		response, err := migration.twilio.ListChatChannels(migration.serviceSID, options)
+
+		if err != nil {
+			return err
+		}
+
+		for _, channel := range response.Channels {
+			err = migration.migrateChannel(channel)
+
+			if err != nil {
+				return err
+			}
+		}
+
+		next := response.Meta.NextPage()
+
+		if next == nil {
+			return nil
+		}
+
+		options.PageOptions = *next
+	}
+}
+
+func (migration *chatMigration) migrateChannel(channel *ChatChannel) error {
+	conversationSID := ""
+
+	if migration.report.DryRun == false {
+		conversationOptions := ConversationOptions{
+			Attributes:  channel.Attributes,
+			DateCreated: channel.DateCreated,
+			DateUpdated: channel.DateUpdated,
+		}
+
+		if channel.FriendlyName != nil {
+			conversationOptions.FriendlyName = *channel.FriendlyName
+		}
+
+		if channel.UniqueName != nil {
+			conversationOptions.UniqueName = *channel.UniqueName
+		}
+
+		conversation, err := migration.twilio.CreateNewConversation(conversationOptions)
+
+		if err != nil {
+			return err
+		}
+
+		conversationSID = conversation.SID
+	}
+
+	migration.report.Channels[channel.SID] = conversationSID
+
+	err := migration.migrateMembers(channel.SID, conversationSID)
+
+	if err != nil {
+		return err
+	}
+
+	return migration.migrateMessages(channel.SID, conversationSID)
+}
+
+func (migration *chatMigration) migrateMembers(channelSID, conversationSID string) error {
+	options := ListChatMembersOptions{}
+
+	for {
+		response, err := migration.twilio.ListChatMembers(migration.serviceSID, channelSID, options)
+
+		if err != nil {
+			return err
+		}
+
+		for _, member := range response.Members {
+			migration.report.Members[member.SID] = ""
+
+			if migration.report.DryRun {
+				continue
+			}
+
+			participant, err := migration.twilio.CreateNewConversationParticipant(conversationSID, ConversationParticipantOptions{
+				Identity:    member.Identity,
+				Attributes:  member.Attributes,
+				DateCreated: member.DateCreated,
+				DateUpdated: member.DateUpdated,
+			})
+
+			if err != nil {
+				return err
+			}
+
+			migration.report.Members[member.SID] = participant.SID
+		}
+
+		next := response.Meta.NextPage()
+
+		if next == nil {
+			return nil
+		}
+
+		options.PageOptions = *next
+	}
+}
+
+func (migration *chatMigration) migrateMessages(channelSID, conversationSID string) error {
+	options := ListChatMessagesOptions{Order: "asc"}
+
+	for {
+		response, err := migration.twilio.ListChatMessages(migration.serviceSID, channelSID, options)
+
+		if err != nil {
+			return err
+		}
+
+		for _, message := range response.Messages {
+			if message.Media != nil && migration.twilio.ConversationServiceSID == "" {
+				return errors.New("Missing required parameter ConversationServiceSID, chat message " + message.SID + " has media that can only be uploaded to a Conversation Service")
+			}
+
+			migration.report.Messages[message.SID] = ""
+
+			if migration.report.DryRun {
+				continue
+			}
+
+			created, err := migration.migrateMessage(conversationSID, message)
+
+			if err != nil {
+				return err
+			}
+
+			migration.report.Messages[message.SID] = created.SID
+		}
+
+		next := response.Meta.NextPage()
+
+		if next == nil {
+			return nil
+		}
+
+		options.PageOptions = *next
+	}
+}
+
+func (migration *chatMigration) migrateMessage(conversationSID string, message *ChatMessage) (*ConversationMessage, error) {
+	options := ConversationMessageOptions{
+		Author:      message.From,
+		Body:        message.Body,
+		Attributes:  message.Attributes,
+		DateCreated: message.DateCreated,
+		DateUpdated: message.DateUpdated,
+	}
+
+	if message.Media == nil {
+		return migration.twilio.CreateNewConversationMessage(conversationSID, options)
+	}
+
+	media, err := migration.twilio.fetchConversationMedia(migration.serviceSID, message.Media.SID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	content, err := migration.twilio.downloadConversationMedia(media)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer content.Close()
+
+	filename := ""
+
+	if media.Filename != nil {
+		filename = *media.Filename
+	}
+
+	uploaded, err := migration.twilio.uploadConversationMedia(migration.twilio.ConversationServiceSID, media.ContentType, filename, content)
+
+	if err != nil {
+		return nil, err
+	}
+
+	options.MediaSID = uploaded.SID
+
+	return migration.twilio.CreateNewConversationMessage(conversationSID, options)
+}
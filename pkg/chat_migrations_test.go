@@ -0,0 +1,259 @@
+package twiligo_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	twiligo "github.com/craigpaul/twiligo/pkg"
+)
+
+var chatMediaMessageResponse = strings.NewReplacer(`"type": "text"`, `"type": "media"`, `"media": null`, `"media": {"sid": "MEXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "content_type": "image/png", "filename": "receipt.png", "size": 4}`).Replace(chatMessageResponse)
+
+func TestWillMigrateChatServiceToConversations(t *testing.T) {
+	requests := []string{}
+
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		requests = append(requests, req.Method+" "+req.URL.Host+req.URL.Path)
+
+		body := ""
+		status := http.StatusOK
+
+		switch req.Method + " " + req.URL.Host + req.URL.Path {
+		case "GET chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Users":
+			body = `{"users": [` + createdChatUserResponse + `], "meta": {"page": 0, "page_size": 50, "key": "users"}}`
+		case "GET chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels":
+			body = chatChannelsResponse
+		case "GET chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Members":
+			body = chatMembersResponse
+		case "GET chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Messages":
+			body = `{"messages": [` + chatMessageResponse + `], "meta": {"page": 0, "page_size": 50, "key": "messages"}}`
+		case "POST conversations.twilio.com/v1/Services/ISYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY/Users":
+			body, status = conversationUserResponse, http.StatusCreated
+		case "POST conversations.twilio.com/v1/Services/ISYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY/Conversations":
+			body, status = createdConversationResponse, http.StatusCreated
+		case "POST conversations.twilio.com/v1/Services/ISYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Participants":
+			body, status = createdConversationParticipantResponse, http.StatusCreated
+		case "POST conversations.twilio.com/v1/Services/ISYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Messages":
+			data, _ := ioutil.ReadAll(req.Body)
+			params, _ := url.ParseQuery(string(data))
+
+			if params.Get("Author") != "system" || params.Get("DateCreated") != "2020-07-30T00:00:00Z" {
+				t.Logf("Incorrect request parameters supplied, expecting the original author and date, but received [%v]", params)
+				t.Fail()
+			}
+
+			body, status = conversationMessageResponse, http.StatusCreated
+		default:
+			t.Logf("Unexpected request was made: %s %s", req.Method, req.URL)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			StatusCode: status,
+			Header:     make(http.Header),
+		}
+	}).WithConversationService("ISYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY")
+
+	report, err := twilio.MigrateChatService("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.ChatMigrationOptions{})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	expected := []string{
+		"GET chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Users",
+		"POST conversations.twilio.com/v1/Services/ISYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY/Users",
+		"GET chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels",
+		"POST conversations.twilio.com/v1/Services/ISYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY/Conversations",
+		"GET chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Members",
+		"POST conversations.twilio.com/v1/Services/ISYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Participants",
+		"GET chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Messages",
+		"POST conversations.twilio.com/v1/Services/ISYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Messages",
+	}
+
+	if strings.Join(requests, ",") != strings.Join(expected, ",") {
+		t.Logf("Incorrect requests made, expected [%v], but received [%v]", expected, requests)
+		t.Fail()
+	}
+
+	if report.Channels["CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"] != "CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX" || report.Members["MBXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"] != "MBXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX" {
+		t.Logf("Incorrect mappings reported, received [%v]", report)
+		t.Fail()
+	}
+
+	if report.Users["USXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"] == "" || report.Messages["IMXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"] == "" {
+		t.Logf("Missing mappings reported, received [%v]", report)
+		t.Fail()
+	}
+}
+
+func TestWillNotCreateAnythingWhenMigratingChatServiceAsDryRun(t *testing.T) {
+	requests := []string{}
+
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		requests = append(requests, req.Method+" "+req.URL.Host+req.URL.Path)
+
+		body := ""
+
+		switch req.URL.Host + req.URL.Path {
+		case "chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Users":
+			body = `{"users": [` + createdChatUserResponse + `], "meta": {"page": 0, "page_size": 50, "key": "users"}}`
+		case "chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels":
+			body = chatChannelsResponse
+		case "chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Members":
+			body = chatMembersResponse
+		case "chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Messages":
+			body = `{"messages": [` + chatMessageResponse + `], "meta": {"page": 0, "page_size": 50, "key": "messages"}}`
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	report, err := twilio.MigrateChatService("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.ChatMigrationOptions{DryRun: true})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	for _, request := range requests {
+		if strings.HasPrefix(request, "GET ") == false {
+			t.Logf("Request was incorrectly made during a dry run: %s", request)
+			t.Fail()
+		}
+	}
+
+	sid, ok := report.Messages["IMXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"]
+
+	if report.DryRun == false || ok == false || sid != "" || len(report.Users) != 1 {
+		t.Logf("Incorrect dry run reported, received [%v]", report)
+		t.Fail()
+	}
+}
+
+func TestWillReuploadMediaWhenMigratingChatServiceToConversations(t *testing.T) {
+	requests := []string{}
+
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		requests = append(requests, req.Method+" "+req.URL.Host+req.URL.Path)
+
+		body, status := "", http.StatusCreated
+
+		switch req.Method + " " + req.URL.Host + req.URL.Path {
+		case "GET chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Users":
+			body, status = `{"users": [], "meta": {"page": 0, "page_size": 50, "key": "users"}}`, http.StatusOK
+		case "GET chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels":
+			body, status = chatChannelsResponse, http.StatusOK
+		case "GET chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Members":
+			body, status = `{"members": [], "meta": {"page": 0, "page_size": 50, "key": "members"}}`, http.StatusOK
+		case "GET chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Messages":
+			body, status = `{"messages": [`+chatMediaMessageResponse+`], "meta": {"page": 0, "page_size": 50, "key": "messages"}}`, http.StatusOK
+		case "GET mcs.us1.twilio.com/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Media/MEXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX":
+			body, status = conversationMediaResponse, http.StatusOK
+		case "GET media.us1.twilio.com/MEXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX":
+			body, status = "\x89PNG", http.StatusOK
+		case "POST mcs.us1.twilio.com/v1/Services/ISYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY/Media":
+			data, _ := ioutil.ReadAll(req.Body)
+
+			if string(data) != "\x89PNG" || req.URL.Query().Get("Filename") != "receipt.png" {
+				t.Logf("Incorrect media uploaded, expecting the original content and filename, but received [%q] (%s)", data, req.URL.Query().Get("Filename"))
+				t.Fail()
+			}
+
+			body = strings.Replace(conversationMediaResponse, "MEXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "MEYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY", -1)
+		case "POST conversations.twilio.com/v1/Services/ISYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY/Conversations":
+			body = createdConversationResponse
+		case "POST conversations.twilio.com/v1/Services/ISYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Messages":
+			data, _ := ioutil.ReadAll(req.Body)
+			params, _ := url.ParseQuery(string(data))
+
+			if params.Get("MediaSid") != "MEYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY" {
+				t.Logf("Incorrect request parameter supplied, expecting [%s], but received [%s]", "MEYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY", params.Get("MediaSid"))
+				t.Fail()
+			}
+
+			body = conversationMessageResponse
+		default:
+			t.Logf("Unexpected request was made: %s %s", req.Method, req.URL)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			StatusCode: status,
+			Header:     make(http.Header),
+		}
+	}).WithConversationService("ISYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY")
+
+	report, err := twilio.MigrateChatService("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.ChatMigrationOptions{})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if report.Messages["IMXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"] == "" {
+		t.Logf("Missing mappings reported, received [%v]", report)
+		t.Fail()
+	}
+}
+
+func TestWillNotMigrateMediaMessagesWithoutConversationService(t *testing.T) {
+	for _, dryRun := range []bool{false, true} {
+		requests := []string{}
+
+		twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+			requests = append(requests, req.Method+" "+req.URL.Host+req.URL.Path)
+
+			body := ""
+
+			switch req.URL.Host + req.URL.Path {
+			case "chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Users":
+				body = `{"users": [` + createdChatUserResponse + `], "meta": {"page": 0, "page_size": 50, "key": "users"}}`
+			case "chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels":
+				body = chatChannelsResponse
+			case "chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Members":
+				body = chatMembersResponse
+			case "chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Messages":
+				body = `{"messages": [` + chatMediaMessageResponse + `], "meta": {"page": 0, "page_size": 50, "key": "messages"}}`
+			}
+
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+			}
+		})
+
+		report, err := twilio.MigrateChatService("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.ChatMigrationOptions{DryRun: dryRun})
+
+		expected := "Missing required parameter ConversationServiceSID, chat message IMXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX has media that can only be uploaded to a Conversation Service"
+
+		if err == nil || err.Error() != expected {
+			t.Logf("Incorrect error returned, expected [%s], but received [%v]", expected, err)
+			t.Fail()
+		}
+
+		if report.DryRun != dryRun {
+			t.Logf("Incorrect report returned, received [%v]", report)
+			t.Fail()
+		}
+
+		for _, request := range requests {
+			if strings.HasPrefix(request, "GET ") == false {
+				t.Logf("Request was incorrectly made without a conversation service: %s", request)
+				t.Fail()
+			}
+		}
+	}
+}
@@ -0,0 +1,179 @@
+package twiligo
+
+import (
+	"net/http"
+	"time"
+)
+
+// ChatChannelAddEvent represents the onChannelAdd pre-event webhook sent from Twilio before a channel is created.
+type ChatChannelAddEvent struct {
+	ChatWebhookEvent
+	ChannelType  ChatChannelType `json:"ChannelType"`
+	FriendlyName string          `json:"FriendlyName"`
+	UniqueName   string          `json:"UniqueName"`
+	Attributes   string          `json:"Attributes"`
+	CreatedBy    string          `json:"CreatedBy"`
+}
+
+// ChatChannelUpdateEvent represents the onChannelUpdate pre-event webhook sent from Twilio before a channel is updated.
+type ChatChannelUpdateEvent struct {
+	ChatWebhookEvent
+	ChannelSID   string          `json:"ChannelSid"`
+	ChannelType  ChatChannelType `json:"ChannelType"`
+	FriendlyName string          `json:"FriendlyName"`
+	UniqueName   string          `json:"UniqueName"`
+	Attributes   string          `json:"Attributes"`
+	CreatedBy    string          `json:"CreatedBy"`
+	DateCreated  time.Time       `json:"DateCreated"`
+	DateUpdated  time.Time       `json:"DateUpdated"`
+}
+
+// ChatChannelDestroyEvent represents the onChannelDestroy pre-event webhook sent from Twilio before a channel is deleted.
+type ChatChannelDestroyEvent struct {
+	ChatWebhookEvent
+	ChannelSID    string          `json:"ChannelSid"`
+	ChannelType   ChatChannelType `json:"ChannelType"`
+	FriendlyName  string          `json:"FriendlyName"`
+	UniqueName    string          `json:"UniqueName"`
+	Attributes    string          `json:"Attributes"`
+	CreatedBy     string          `json:"CreatedBy"`
+	MembersCount  int             `json:"MembersCount"`
+	MessagesCount int             `json:"MessagesCount"`
+	DateCreated   time.Time       `json:"DateCreated"`
+}
+
+// ChatMessageSendEvent represents the onMessageSend pre-event webhook sent from Twilio before a text message is added to a channel.
+type ChatMessageSendEvent struct {
+	ChatWebhookEvent
+	ChannelSID string `json:"ChannelSid"`
+	From       string `json:"From"`
+	Body       string `json:"Body"`
+	Attributes string `json:"Attributes"`
+}
+
+// ChatMediaMessageSendEvent represents the onMediaMessageSend pre-event webhook sent from Twilio before a media message is added to a channel.
+type ChatMediaMessageSendEvent struct {
+	ChatWebhookEvent
+	ChannelSID       string `json:"ChannelSid"`
+	From             string `json:"From"`
+	Attributes       string `json:"Attributes"`
+	MediaSID         string `json:"MediaSid"`
+	MediaContentType string `json:"MediaContentType"`
+	MediaFilename    string `json:"MediaFilename"`
+	MediaSize        int    `json:"MediaSize"`
+}
+
+// ChatMessageUpdateEvent represents the onMessageUpdate pre-event webhook sent from Twilio before a message within a channel is updated.
+type ChatMessageUpdateEvent struct {
+	ChatWebhookEvent
+	ChannelSID  string    `json:"ChannelSid"`
+	MessageSID  string    `json:"MessageSid"`
+	Index       int       `json:"Index"`
+	From        string    `json:"From"`
+	Body        string    `json:"Body"`
+	Attributes  string    `json:"Attributes"`
+	ModifiedBy  string    `json:"ModifiedBy"`
+	DateCreated time.Time `json:"DateCreated"`
+}
+
+// ChatMessageRemoveEvent represents the onMessageRemove pre-event webhook sent from Twilio before a message is deleted from a channel.
+type ChatMessageRemoveEvent struct {
+	ChatWebhookEvent
+	ChannelSID  string    `json:"ChannelSid"`
+	MessageSID  string    `json:"MessageSid"`
+	Index       int       `json:"Index"`
+	From        string    `json:"From"`
+	Body        string    `json:"Body"`
+	Attributes  string    `json:"Attributes"`
+	ModifiedBy  string    `json:"ModifiedBy"`
+	DateCreated time.Time `json:"DateCreated"`
+}
+
+// ChatMemberAddEvent represents the onMemberAdd pre-event webhook sent from Twilio before a member joins or is added to a channel.
+type ChatMemberAddEvent struct {
+	ChatWebhookEvent
+	ChannelSID string `json:"ChannelSid"`
+	Identity   string `json:"Identity"`
+	RoleSID    string `json:"RoleSid"`
+	Reason     string `json:"Reason"`
+}
+
+// ChatMemberUpdateEvent represents the onMemberUpdate pre-event webhook sent from Twilio before a member within a channel is updated.
+type ChatMemberUpdateEvent struct {
+	ChatWebhookEvent
+	ChannelSID               string    `json:"ChannelSid"`
+	MemberSID                string    `json:"MemberSid"`
+	Identity                 string    `json:"Identity"`
+	RoleSID                  string    `json:"RoleSid"`
+	Attributes               string    `json:"Attributes"`
+	LastConsumedMessageIndex *int      `json:"LastConsumedMessageIndex"`
+	DateCreated              time.Time `json:"DateCreated"`
+}
+
+// ChatMemberRemoveEvent represents the onMemberRemove pre-event webhook sent from Twilio before a member leaves or is removed from a channel.
+type ChatMemberRemoveEvent struct {
+	ChatWebhookEvent
+	ChannelSID  string    `json:"ChannelSid"`
+	MemberSID   string    `json:"MemberSid"`
+	Identity    string    `json:"Identity"`
+	Reason      string    `json:"Reason"`
+	DateCreated time.Time `json:"DateCreated"`
+}
+
+// ChatUserUpdateEvent represents the onUserUpdate pre-event webhook sent from Twilio before a chat user is updated.
+type ChatUserUpdateEvent struct {
+	ChatWebhookEvent
+	UserSID      string    `json:"UserSid"`
+	Identity     string    `json:"Identity"`
+	FriendlyName string    `json:"FriendlyName"`
+	Attributes   string    `json:"Attributes"`
+	RoleSID      string    `json:"RoleSid"`
+	DateCreated  time.Time `json:"DateCreated"`
+}
+
+// ChatActionModification holds the values that should replace those of the pending action when responding to a Chat pre-event webhook. Body and From apply to messages, FriendlyName and UniqueName apply to channels, and any field left nil is kept as it was sent.
+type ChatActionModification struct {
+	Body         *string `json:"body,omitempty"`
+	From         *string `json:"from,omitempty"`
+	Attributes   *string `json:"attributes,omitempty"`
+	FriendlyName *string `json:"friendly_name,omitempty"`
+	UniqueName   *string `json:"unique_name,omitempty"`
+}
+
+// ChatPreEventHandlerFunc handles a single Chat pre-event webhook, deciding whether the action should be accepted, rejected or modified via the given response writer.
+type ChatPreEventHandlerFunc func(event ChatEvent, w *ChatPreEventResponseWriter)
+
+// ChatPreEventResponseWriter writes the response to a Chat pre-event webhook, telling Twilio whether the pending action should go ahead. Only the first call to Accept, Reject or Modify takes effect.
+type ChatPreEventResponseWriter struct {
+	*preEventResponseWriter
+}
+
+// NewChatPreEventHandler creates an http.Handler for Chat pre-event webhooks. Requests are verified with CheckSignature against the given base URL before being parsed and passed along to the given function, and actions are accepted unless the function rejects or modifies them.
+func (twilio *Twilio) NewChatPreEventHandler(baseURL string, handle ChatPreEventHandlerFunc) http.Handler {
+	return twilio.newSignedWebhookHandler(baseURL, func(r *http.Request, response *preEventResponseWriter) error {
+		event, err := ParseChatWebhook(r)
+
+		if err != nil {
+			return err
+		}
+
+		handle(event, &ChatPreEventResponseWriter{response})
+
+		return nil
+	})
+}
+
+// Accept lets the pending action go ahead unchanged.
+func (response *ChatPreEventResponseWriter) Accept() {
+	response.writeStatus(http.StatusOK)
+}
+
+// Reject stops the pending action from taking place.
+func (response *ChatPreEventResponseWriter) Reject() {
+	response.writeStatus(http.StatusForbidden)
+}
+
+// Modify lets the pending action go ahead using the values of the given modification in place of those that were sent.
+func (response *ChatPreEventResponseWriter) Modify(modification ChatActionModification) error {
+	return response.writeJSON(modification)
+}
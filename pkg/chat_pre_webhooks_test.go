@@ -0,0 +1,106 @@
+package twiligo_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	twiligo "github.com/craigpaul/twiligo/pkg"
+)
+
+func TestWillAcceptChatPreEventWhenHandlerDoesNotRespond(t *testing.T) {
+	twilio := twiligo.New("123", "456")
+
+	handler := twilio.NewChatPreEventHandler("https://example.com", func(event twiligo.ChatEvent, w *twiligo.ChatPreEventResponseWriter) {
+		if _, ok := event.(*twiligo.ChatMemberAddEvent); ok == false {
+			t.Logf("Incorrect event received, expected [%T], but received [%T]", &twiligo.ChatMemberAddEvent{}, event)
+			t.Fail()
+		}
+	})
+
+	recorder := httptest.NewRecorder()
+
+	handler.ServeHTTP(recorder, NewSignedTestWebhookRequest(twilio, url.Values{
+		"EventType": {"onMemberAdd"},
+		"Identity":  {"jing"},
+	}))
+
+	if recorder.Code != http.StatusOK || recorder.Body.Len() != 0 {
+		t.Logf("Incorrect response written, expected an empty [%d], but received [%d] %s", http.StatusOK, recorder.Code, recorder.Body)
+		t.Fail()
+	}
+}
+
+func TestWillRejectChatPreEventWhenHandlerRejectsAction(t *testing.T) {
+	twilio := twiligo.New("123", "456")
+
+	handler := twilio.NewChatPreEventHandler("https://example.com", func(event twiligo.ChatEvent, w *twiligo.ChatPreEventResponseWriter) {
+		w.Reject()
+		w.Accept()
+	})
+
+	recorder := httptest.NewRecorder()
+
+	handler.ServeHTTP(recorder, NewSignedTestWebhookRequest(twilio, url.Values{
+		"EventType": {"onChannelDestroy"},
+	}))
+
+	if recorder.Code != http.StatusForbidden {
+		t.Logf("Incorrect status code written, expected [%d], but received [%d]", http.StatusForbidden, recorder.Code)
+		t.Fail()
+	}
+}
+
+func TestWillModifyChatPreEventWhenHandlerModifiesAction(t *testing.T) {
+	twilio := twiligo.New("123", "456")
+
+	handler := twilio.NewChatPreEventHandler("https://example.com", func(event twiligo.ChatEvent, w *twiligo.ChatPreEventResponseWriter) {
+		message := event.(*twiligo.ChatMessageSendEvent)
+
+		body := "H*llo"
+
+		if message.Body == "Hello" {
+			w.Modify(twiligo.ChatActionModification{Body: &body})
+		}
+	})
+
+	recorder := httptest.NewRecorder()
+
+	handler.ServeHTTP(recorder, NewSignedTestWebhookRequest(twilio, url.Values{
+		"EventType": {"onMessageSend"},
+		"Body":      {"Hello"},
+	}))
+
+	response := map[string]string{}
+
+	json.Unmarshal(recorder.Body.Bytes(), &response)
+
+	if recorder.Code != http.StatusOK || len(response) != 1 || response["body"] != "H*llo" {
+		t.Logf("Incorrect response written, received [%d] %s", recorder.Code, recorder.Body)
+		t.Fail()
+	}
+}
+
+func TestWillNotHandleChatPreEventWithInvalidSignature(t *testing.T) {
+	twilio := twiligo.New("123", "456")
+
+	handler := twilio.NewChatPreEventHandler("https://example.com", func(event twiligo.ChatEvent, w *twiligo.ChatPreEventResponseWriter) {
+		t.Logf("Handler was incorrectly called, was not expecting the following event: %v", event)
+		t.Fail()
+	})
+
+	req := NewTestWebhookRequest(url.Values{"EventType": {"onMessageSend"}})
+
+	req.Header.Set("X-Twilio-Signature", "invalid")
+
+	recorder := httptest.NewRecorder()
+
+	handler.ServeHTTP(recorder, req)
+
+	if recorder.Code != http.StatusForbidden {
+		t.Logf("Incorrect status code written, expected [%d], but received [%d]", http.StatusForbidden, recorder.Code)
+		t.Fail()
+	}
+}
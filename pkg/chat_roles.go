@@ -0,0 +1,321 @@
+package twiligo
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+// This constant is used to represent whether a ChatRole applies to a single Channel or to the entire chat service.
+const (
+	ChannelChatRole ChatRoleType = iota + 1
+	DeploymentChatRole
+)
+
+// This constant is used to represent the permissions that can only be granted by a DeploymentChatRole.
+const (
+	ChatCreateChannelPermission ChatPermission = iota + 1
+	ChatJoinChannelPermission
+	ChatEditOwnUserInfoPermission
+	ChatEditAnyUserInfoPermission
+)
+
+// This constant is used to represent the permissions that can be granted by either a ChannelChatRole or a DeploymentChatRole.
+const (
+	ChatAddMemberPermission ChatPermission = iota + 100
+	ChatInviteMemberPermission
+	ChatRemoveMemberPermission
+	ChatLeaveChannelPermission
+	ChatDestroyChannelPermission
+	ChatEditChannelNamePermission
+	ChatEditChannelAttributesPermission
+	ChatSendMessagePermission
+	ChatSendMediaMessagePermission
+	ChatEditOwnMessagePermission
+	ChatEditAnyMessagePermission
+	ChatEditOwnMessageAttributesPermission
+	ChatEditAnyMessageAttributesPermission
+	ChatDeleteOwnMessagePermission
+	ChatDeleteAnyMessagePermission
+)
+
+// ChatPermission is used to define a single action that a ChatRole allows a user or member to take.
+type ChatPermission int
+
+// ChatRole represents a named set of permissions that can be assigned to users or members within a chat service.
+type ChatRole struct {
+	SID          string           `json:"sid"`
+	AccountSID   string           `json:"account_sid"`
+	ServiceSID   string           `json:"service_sid"`
+	FriendlyName string           `json:"friendly_name"`
+	Type         ChatRoleType     `json:"type"`
+	Permissions  []ChatPermission `json:"permissions"`
+	DateCreated  time.Time        `json:"date_created"`
+	DateUpdated  time.Time        `json:"date_updated"`
+	URL          string           `json:"url"`
+}
+
+// ChatRolesResponse is the representation of the JSON response from Twilio when listing chat roles.
+type ChatRolesResponse struct {
+	Roles []*ChatRole `json:"roles"`
+	Meta  Meta        `json:"meta"`
+}
+
+// ChatRoleType is used to define whether a ChatRole applies to a single Channel or to the entire chat service.
+type ChatRoleType int
+
+type chatRoleOptions struct {
+	FriendlyName string           `url:",omitempty"`
+	Type         ChatRoleType     `url:",omitempty"`
+	Permission   []ChatPermission `url:",omitempty"`
+}
+
+// CreateNewChatRole creates a new role within the given chat service in Twilio that grants the given permissions. A ChannelChatRole cannot grant any of the permissions reserved for a DeploymentChatRole.
+func (twilio *Twilio) CreateNewChatRole(serviceSID, name string, roleType ChatRoleType, permissions []ChatPermission) (*ChatRole, error) {
+	if len(permissions) == 0 {
+		return nil, errors.New("Missing required parameter Permission")
+	}
+
+	for _, permission := range permissions {
+		if permission.Scope() == DeploymentChatRole && roleType != DeploymentChatRole {
+			return nil, errors.New("Permission " + permission.String() + " cannot be granted by a " + roleType.String() + " role")
+		}
+	}
+
+	params, err := query.Values(chatRoleOptions{
+		FriendlyName: name,
+		Type:         roleType,
+		Permission:   permissions,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.chatURL("Services/"+serviceSID+"/Roles"), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusCreated {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatRole)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// FetchChatRole retrieves the role matching the given identifier from the given chat service in Twilio.
+func (twilio *Twilio) FetchChatRole(serviceSID, roleSID string) (*ChatRole, error) {
+	res, err := twilio.get(twilio.chatURL("Services/"+serviceSID+"/Roles/"+roleSID), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatRole)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// ListChatRoles retrieves a single page of the roles within the given chat service from Twilio.
+func (twilio *Twilio) ListChatRoles(serviceSID string, options PageOptions) (*ChatRolesResponse, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.get(twilio.chatURL("Services/"+serviceSID+"/Roles"), &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatRolesResponse)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// UpdateChatRole will replace the permissions of an existing role within the given chat service in Twilio with the given permissions.
+func (twilio *Twilio) UpdateChatRole(serviceSID, roleSID string, permissions []ChatPermission) (*ChatRole, error) {
+	if len(permissions) == 0 {
+		return nil, errors.New("Missing required parameter Permission")
+	}
+
+	params, err := query.Values(chatRoleOptions{Permission: permissions})
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.chatURL("Services/"+serviceSID+"/Roles/"+roleSID), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatRole)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// DeleteChatRole will completely remove the role matching the given identifier from the given chat service within Twilio.
+func (twilio *Twilio) DeleteChatRole(serviceSID, roleSID string) error {
+	res, err := twilio.delete(twilio.chatURL("Services/" + serviceSID + "/Roles/" + roleSID))
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		decoder := json.NewDecoder(res.Body)
+
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return err
+	}
+
+	return nil
+}
+
+// Scope returns the narrowest type of role that is able to grant the current permission.
+func (permission ChatPermission) Scope() ChatRoleType {
+	if permission >= ChatAddMemberPermission {
+		return ChannelChatRole
+	}
+
+	return DeploymentChatRole
+}
+
+// MarshalJSON handles converting a ChatPermission into the string representation used by Twilio.
+func (permission ChatPermission) MarshalJSON() ([]byte, error) {
+	return json.Marshal(permission.String())
+}
+
+// UnmarshalJSON handles converting the string representation used by Twilio into a ChatPermission.
+func (permission *ChatPermission) UnmarshalJSON(b []byte) error {
+	var s string
+
+	err := json.Unmarshal(b, &s)
+
+	if err != nil {
+		return err
+	}
+
+	*permission = ConvertPermissionToChatPermission(s)
+
+	return nil
+}
+
+func (permission ChatPermission) String() string {
+	return map[ChatPermission]string{
+		ChatCreateChannelPermission:            "createChannel",
+		ChatJoinChannelPermission:              "joinChannel",
+		ChatEditOwnUserInfoPermission:          "editOwnUserInfo",
+		ChatEditAnyUserInfoPermission:          "editAnyUserInfo",
+		ChatAddMemberPermission:                "addMember",
+		ChatInviteMemberPermission:             "inviteMember",
+		ChatRemoveMemberPermission:             "removeMember",
+		ChatLeaveChannelPermission:             "leaveChannel",
+		ChatDestroyChannelPermission:           "destroyChannel",
+		ChatEditChannelNamePermission:          "editChannelName",
+		ChatEditChannelAttributesPermission:    "editChannelAttributes",
+		ChatSendMessagePermission:              "sendMessage",
+		ChatSendMediaMessagePermission:         "sendMediaMessage",
+		ChatEditOwnMessagePermission:           "editOwnMessage",
+		ChatEditAnyMessagePermission:           "editAnyMessage",
+		ChatEditOwnMessageAttributesPermission: "editOwnMessageAttributes",
+		ChatEditAnyMessageAttributesPermission: "editAnyMessageAttributes",
+		ChatDeleteOwnMessagePermission:         "deleteOwnMessage",
+		ChatDeleteAnyMessagePermission:         "deleteAnyMessage",
+	}[permission]
+}
+
+// MarshalJSON handles converting a ChatRoleType into the string representation used by Twilio.
+func (roleType ChatRoleType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(roleType.String())
+}
+
+// UnmarshalJSON handles converting the string representation used by Twilio into a ChatRoleType.
+func (roleType *ChatRoleType) UnmarshalJSON(b []byte) error {
+	var s string
+
+	err := json.Unmarshal(b, &s)
+
+	if err != nil {
+		return err
+	}
+
+	*roleType = ConvertTypeToChatRoleType(s)
+
+	return nil
+}
+
+func (roleType ChatRoleType) String() string {
+	return map[ChatRoleType]string{
+		ChannelChatRole:    "channel",
+		DeploymentChatRole: "deployment",
+	}[roleType]
+}
@@ -0,0 +1,118 @@
+package twiligo_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+
+	twiligo "github.com/craigpaul/twiligo/pkg"
+)
+
+const chatRoleResponse = `{
+	"sid": "RLXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"account_sid": "ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"service_sid": "ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"friendly_name": "moderator",
+	"type": "channel",
+	"permissions": ["sendMessage", "removeMember"],
+	"date_created": "2020-07-30T00:00:00Z",
+	"date_updated": "2020-07-30T00:00:00Z",
+	"url": "https://chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Roles/RLXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}`
+
+func TestWillMakeRequestToCreateNewChatRoleSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Roles"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		if params.Get("Type") != "channel" || params.Get("FriendlyName") != "moderator" {
+			t.Logf("Incorrect request parameters supplied, received [%v]", params)
+			t.Fail()
+		}
+
+		if len(params["Permission"]) != 2 || params["Permission"][1] != "removeMember" {
+			t.Logf("Incorrect request parameter Permission supplied, received [%v]", params["Permission"])
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(chatRoleResponse)),
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.CreateNewChatRole("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "moderator", twiligo.ChannelChatRole, []twiligo.ChatPermission{
+		twiligo.ChatSendMessagePermission,
+		twiligo.ChatRemoveMemberPermission,
+	})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if response.Type != twiligo.ChannelChatRole || len(response.Permissions) != 2 || response.Permissions[1] != twiligo.ChatRemoveMemberPermission {
+		t.Logf("Incorrect role decoded, received [%v]", response)
+		t.Fail()
+	}
+}
+
+func TestWillNotMakeRequestToCreateNewChannelChatRoleWithDeploymentPermission(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		t.Logf("Request was incorrectly made, was not expecting the following request: %v", req)
+		t.FailNow()
+
+		return &http.Response{}
+	})
+
+	_, err := twilio.CreateNewChatRole("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "moderator", twiligo.ChannelChatRole, []twiligo.ChatPermission{
+		twiligo.ChatCreateChannelPermission,
+	})
+
+	expected := "Permission createChannel cannot be granted by a channel role"
+
+	if err == nil || err.Error() != expected {
+		t.Logf("Incorrect error returned, expected [%s], but received [%v]", expected, err)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToUpdateChatRoleSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		if _, ok := params["Type"]; ok {
+			t.Log("Empty request parameter Type was incorrectly supplied")
+			t.Fail()
+		}
+
+		if params.Get("Permission") != "sendMessage" {
+			t.Logf("Incorrect request parameter supplied, expecting [%s], but received [%s]", "sendMessage", params.Get("Permission"))
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(chatRoleResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	_, err := twilio.UpdateChatRole("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "RLXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", []twiligo.ChatPermission{twiligo.ChatSendMessagePermission})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+}
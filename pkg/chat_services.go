@@ -3,14 +3,31 @@ package twiligo
 import (
 	"encoding/json"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/google/go-querystring/query"
 )
 
+// ChatNotificationOptions are all of the options that can be provided for a single type of push notification sent by a chat service.
+type ChatNotificationOptions struct {
+	Enabled  *bool  `url:",omitempty"`
+	Template string `url:",omitempty"`
+	Sound    string `url:",omitempty"`
+}
+
+// ChatNewMessageNotificationOptions are all of the options that can be provided for the push notification sent by a chat service when a new message is added to a channel.
+type ChatNewMessageNotificationOptions struct {
+	Enabled           *bool  `url:",omitempty"`
+	Template          string `url:",omitempty"`
+	Sound             string `url:",omitempty"`
+	BadgeCountEnabled *bool  `url:",omitempty"`
+}
+
 // ChatService represents a Twilio Chat service that owns one or more channels, users, messages, etc.
 type ChatService struct {
 	AccountSID                   string    `json:"account_sid"`
+	ConsumptionReportInterval    int       `json:"consumption_report_interval"`
 	DateCreated                  time.Time `json:"date_created"`
 	DateUpdated                  time.Time `json:"date_updated"`
 	DefaultChannelCreatorRoleSID string    `json:"default_channel_creator_role_sid"`
@@ -29,17 +46,26 @@ type ChatService struct {
 	} `json:"links"`
 	Notifications struct {
 		RemovedFromChannel struct {
-			Enabled bool `json:"enabled"`
+			Enabled  bool    `json:"enabled"`
+			Template *string `json:"template"`
+			Sound    *string `json:"sound"`
 		} `json:"removed_from_channel"`
 		LogEnabled     bool `json:"log_enabled"`
 		AddedToChannel struct {
-			Enabled bool `json:"enabled"`
+			Enabled  bool    `json:"enabled"`
+			Template *string `json:"template"`
+			Sound    *string `json:"sound"`
 		} `json:"added_to_channel"`
 		NewMessage struct {
-			Enabled bool `json:"enabled"`
+			Enabled           bool    `json:"enabled"`
+			Template          *string `json:"template"`
+			Sound             *string `json:"sound"`
+			BadgeCountEnabled bool    `json:"badge_count_enabled"`
 		} `json:"new_message"`
 		InvitedToChannel struct {
-			Enabled bool `json:"enabled"`
+			Enabled  bool    `json:"enabled"`
+			Template *string `json:"template"`
+			Sound    *string `json:"sound"`
 		} `json:"invited_to_channel"`
 	} `json:"notifications"`
 	Media struct {
@@ -53,12 +79,59 @@ type ChatService struct {
 	ReachabilityEnabled    bool      `json:"reachability_enabled"`
 	ReadStatusEnabled      bool      `json:"read_status_enabled"`
 	SID                    string    `json:"sid"`
-	TypingIndicatorTimeout int       `json:"typing_indicator_timout"`
+	TypingIndicatorTimeout int       `json:"typing_indicator_timeout"`
 	URL                    string    `json:"url"`
 	WebhookFilters         *[]string `json:"webhook_filters"`
 	WebhookMethod          *string   `json:"webhook_method"`
 }
 
+// ChatServiceLimitsOptions are all of the options that can be provided to limit the size of channels within a chat service.
+type ChatServiceLimitsOptions struct {
+	ChannelMembers int `url:",omitempty"`
+	UserChannels   int `url:",omitempty"`
+}
+
+// ChatServiceMediaOptions are all of the options that can be provided to control how media messages are handled by a chat service.
+type ChatServiceMediaOptions struct {
+	CompatibilityMessage string `url:",omitempty"`
+}
+
+// ChatServiceNotificationsOptions are all of the options that can be provided to control the push notifications sent by a chat service.
+type ChatServiceNotificationsOptions struct {
+	LogEnabled         *bool                             `url:",omitempty"`
+	NewMessage         ChatNewMessageNotificationOptions `url:",omitempty"`
+	AddedToChannel     ChatNotificationOptions           `url:",omitempty"`
+	RemovedFromChannel ChatNotificationOptions           `url:",omitempty"`
+	InvitedToChannel   ChatNotificationOptions           `url:",omitempty"`
+}
+
+// ChatServicesResponse is the representation of the JSON response from Twilio when listing chat services.
+type ChatServicesResponse struct {
+	Services []*ChatService `json:"services"`
+	Meta     Meta           `json:"meta"`
+}
+
+// UpdateChatServiceOptions are all of the options that can be provided to an UpdateChatService call. TypingIndicatorTimeout and ConsumptionReportInterval are both in seconds.
+type UpdateChatServiceOptions struct {
+	FriendlyName                 string                          `url:",omitempty"`
+	DefaultServiceRoleSID        string                          `url:"DefaultServiceRoleSid,omitempty"`
+	DefaultChannelRoleSID        string                          `url:"DefaultChannelRoleSid,omitempty"`
+	DefaultChannelCreatorRoleSID string                          `url:"DefaultChannelCreatorRoleSid,omitempty"`
+	ReadStatusEnabled            *bool                           `url:",omitempty"`
+	ReachabilityEnabled          *bool                           `url:",omitempty"`
+	TypingIndicatorTimeout       int                             `url:",omitempty"`
+	ConsumptionReportInterval    int                             `url:",omitempty"`
+	Notifications                ChatServiceNotificationsOptions `url:",omitempty"`
+	PreWebhookURL                string                          `url:"PreWebhookUrl,omitempty"`
+	PostWebhookURL               string                          `url:"PostWebhookUrl,omitempty"`
+	WebhookMethod                string                          `url:",omitempty"`
+	WebhookFilters               []string                        `url:",omitempty"`
+	PreWebhookRetryCount         *int                            `url:",omitempty"`
+	PostWebhookRetryCount        *int                            `url:",omitempty"`
+	Limits                       ChatServiceLimitsOptions        `url:",omitempty"`
+	Media                        ChatServiceMediaOptions         `url:",omitempty"`
+}
+
 type createNewChatServiceOptions struct {
 	FriendlyName string
 }
@@ -95,3 +168,144 @@ func (twilio *Twilio) CreateNewChatService(name string) (*ChatService, error) {
 
 	return response, nil
 }
+
+// FetchChatService retrieves the chat service matching the given identifier from Twilio.
+func (twilio *Twilio) FetchChatService(serviceSID string) (*ChatService, error) {
+	res, err := twilio.get(twilio.chatURL("Services/"+serviceSID), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatService)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// ListChatServices retrieves a single page of chat services from Twilio.
+func (twilio *Twilio) ListChatServices(options PageOptions) (*ChatServicesResponse, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.get(twilio.chatURL("Services"), &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatServicesResponse)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// UpdateChatService will update an existing chat service in Twilio based on the provided identifier and options.
+func (twilio *Twilio) UpdateChatService(serviceSID string, options UpdateChatServiceOptions) (*ChatService, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.chatURL("Services/"+serviceSID), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatService)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// DeleteChatService will completely remove the chat service matching the given identifier, along with all of its channels, users and messages, from within Twilio.
+func (twilio *Twilio) DeleteChatService(serviceSID string) error {
+	res, err := twilio.delete(twilio.chatURL("Services/" + serviceSID))
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		decoder := json.NewDecoder(res.Body)
+
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return err
+	}
+
+	return nil
+}
+
+// EncodeValues handles adding the given notification options to the request parameters using the dot notation (e.g. Notifications.AddedToChannel.Enabled) that Twilio expects.
+func (options ChatNotificationOptions) EncodeValues(key string, v *url.Values) error {
+	return encodeNestedValues(key, options, v)
+}
+
+// EncodeValues handles adding the given new message notification options to the request parameters using the dot notation (e.g. Notifications.NewMessage.Enabled) that Twilio expects.
+func (options ChatNewMessageNotificationOptions) EncodeValues(key string, v *url.Values) error {
+	return encodeNestedValues(key, options, v)
+}
+
+// EncodeValues handles adding the given limits to the request parameters using the dot notation (e.g. Limits.ChannelMembers) that Twilio expects.
+func (options ChatServiceLimitsOptions) EncodeValues(key string, v *url.Values) error {
+	return encodeNestedValues(key, options, v)
+}
+
+// EncodeValues handles adding the given media options to the request parameters using the dot notation (e.g. Media.CompatibilityMessage) that Twilio expects.
+func (options ChatServiceMediaOptions) EncodeValues(key string, v *url.Values) error {
+	return encodeNestedValues(key, options, v)
+}
+
+// EncodeValues handles adding the given notifications options to the request parameters using the dot notation (e.g. Notifications.LogEnabled) that Twilio expects.
+func (options ChatServiceNotificationsOptions) EncodeValues(key string, v *url.Values) error {
+	return encodeNestedValues(key, options, v)
+}
@@ -7,6 +7,8 @@ import (
 	"net/url"
 	"strings"
 	"testing"
+
+	twiligo "github.com/craigpaul/twiligo/pkg"
 )
 
 const createdChatServiceResponse = `{
@@ -136,3 +138,147 @@ func TestWillHandleErrorResponsesWhenMakingRequestToCreateNewChatService(t *test
 		t.Fail()
 	}
 }
+
+func TestWillMakeRequestToFetchChatServiceSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+
+		if req.Method != http.MethodGet || req.URL.Path != expected {
+			t.Logf("Incorrect request supplied, expecting [GET %s], but received [%s %s]", expected, req.Method, req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(createdChatServiceResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.FetchChatService("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX")
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if response.TypingIndicatorTimeout != 5 {
+		t.Logf("Incorrect typing indicator timeout decoded, expecting [%d], but received [%d]", 5, response.TypingIndicatorTimeout)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToListChatServicesSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		if req.URL.Query().Get("PageSize") != "20" {
+			t.Logf("Incorrect query parameter supplied, expecting [%s], but received [%s]", "20", req.URL.Query().Get("PageSize"))
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"services": [` + createdChatServiceResponse + `], "meta": {"page": 0, "page_size": 20, "key": "services"}}`)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.ListChatServices(twiligo.PageOptions{PageSize: 20})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if len(response.Services) != 1 {
+		t.Logf("Incorrect services decoded, received [%v]", response.Services)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToUpdateChatServiceWithNestedOptionsSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		expected := map[string]string{
+			"Notifications.NewMessage.Enabled":           "true",
+			"Notifications.NewMessage.Template":          "${USER}: ${MESSAGE}",
+			"Notifications.NewMessage.BadgeCountEnabled": "true",
+			"Notifications.AddedToChannel.Sound":         "ding",
+			"Limits.ChannelMembers":                      "50",
+			"PreWebhookUrl":                              "https://example.com/pre",
+			"ReadStatusEnabled":                          "false",
+			"TypingIndicatorTimeout":                     "10",
+		}
+
+		for key, value := range expected {
+			if params.Get(key) != value {
+				t.Logf("Incorrect request parameter %s supplied, expecting [%s], but received [%s]", key, value, params.Get(key))
+				t.Fail()
+			}
+		}
+
+		if len(params["WebhookFilters"]) != 2 {
+			t.Logf("Incorrect request parameter WebhookFilters supplied, received [%v]", params["WebhookFilters"])
+			t.Fail()
+		}
+
+		if _, ok := params["Limits.UserChannels"]; ok {
+			t.Log("Empty request parameter Limits.UserChannels was incorrectly supplied")
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(createdChatServiceResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	enabled, disabled := true, false
+
+	_, err := twilio.UpdateChatService("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.UpdateChatServiceOptions{
+		ReadStatusEnabled:      &disabled,
+		TypingIndicatorTimeout: 10,
+		Notifications: twiligo.ChatServiceNotificationsOptions{
+			NewMessage: twiligo.ChatNewMessageNotificationOptions{
+				Enabled:           &enabled,
+				Template:          "${USER}: ${MESSAGE}",
+				BadgeCountEnabled: &enabled,
+			},
+			AddedToChannel: twiligo.ChatNotificationOptions{Sound: "ding"},
+		},
+		PreWebhookURL:  "https://example.com/pre",
+		WebhookFilters: []string{"onMessageSend", "onChannelAdd"},
+		Limits:         twiligo.ChatServiceLimitsOptions{ChannelMembers: 50},
+	})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToDeleteChatServiceSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+
+		if req.Method != http.MethodDelete || req.URL.Path != expected {
+			t.Logf("Incorrect request supplied, expecting [DELETE %s], but received [%s %s]", expected, req.Method, req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(``)),
+			StatusCode: http.StatusNoContent,
+			Header:     make(http.Header),
+		}
+	})
+
+	err := twilio.DeleteChatService("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX")
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+}
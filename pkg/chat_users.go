@@ -3,6 +3,7 @@ package twiligo
 import (
 	"encoding/json"
 	"net/http"
+	"net/url"
 	"time"
 
 	"github.com/google/go-querystring/query"
@@ -36,6 +37,50 @@ type CreateNewChatUserOptions struct {
 	FriendlyName string `url:",omitempty"`
 }
 
+// ChatUserChannel represents a single ChatChannel that a given ChatUser has joined or been invited to, including their unread message count and notification level.
+type ChatUserChannel struct {
+	AccountSID               string            `json:"account_sid"`
+	ServiceSID               string            `json:"service_sid"`
+	ChannelSID               string            `json:"channel_sid"`
+	UserSID                  string            `json:"user_sid"`
+	MemberSID                string            `json:"member_sid"`
+	Status                   string            `json:"status"`
+	LastConsumedMessageIndex *int              `json:"last_consumed_message_index"`
+	UnreadMessagesCount      *int              `json:"unread_messages_count"`
+	NotificationLevel        NotificationLevel `json:"notification_level"`
+	Links                    struct {
+		Channel string `json:"channel"`
+		Member  string `json:"member"`
+	} `json:"links"`
+	URL string `json:"url"`
+}
+
+// ChatUserChannelsResponse is the representation of the JSON response from Twilio when listing the channels of a given chat user.
+type ChatUserChannelsResponse struct {
+	Channels []*ChatUserChannel `json:"channels"`
+	Meta     Meta               `json:"meta"`
+}
+
+// ChatUsersResponse is the representation of the JSON response from Twilio when listing chat users.
+type ChatUsersResponse struct {
+	Users []*ChatUser `json:"users"`
+	Meta  Meta        `json:"meta"`
+}
+
+// UpdateChatUserChannelOptions are all of the options that can be provided to an UpdateChatUserChannel call.
+type UpdateChatUserChannelOptions struct {
+	NotificationLevel        NotificationLevel `url:",omitempty"`
+	LastConsumedMessageIndex *int              `url:",omitempty"`
+	LastConsumptionTimestamp time.Time         `url:",omitempty"`
+}
+
+// UpdateChatUserOptions are all of the options that can be provided to an UpdateChatUser call.
+type UpdateChatUserOptions struct {
+	RoleSID      string `url:"RoleSid,omitempty"`
+	Attributes   string `url:",omitempty"`
+	FriendlyName string `url:",omitempty"`
+}
+
 // CreateNewChatUser creates a new chat user for the given chat service in Twilio.
 func (twilio *Twilio) CreateNewChatUser(identity, serviceSID string, options CreateNewChatUserOptions) (*ChatUser, error) {
 	params, err := query.Values(options)
@@ -70,3 +115,235 @@ func (twilio *Twilio) CreateNewChatUser(identity, serviceSID string, options Cre
 
 	return response, nil
 }
+
+// FetchChatUser retrieves the chat user matching the given identifier, which may be either the SID or the Identity of the user, from Twilio.
+func (twilio *Twilio) FetchChatUser(serviceSID, userSID string) (*ChatUser, error) {
+	res, err := twilio.get(twilio.chatURL("Services/"+serviceSID+"/Users/"+url.PathEscape(userSID)), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatUser)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// ListChatUsers retrieves a single page of the users within the given chat service from Twilio.
+func (twilio *Twilio) ListChatUsers(serviceSID string, options PageOptions) (*ChatUsersResponse, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.get(twilio.chatURL("Services/"+serviceSID+"/Users"), &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatUsersResponse)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// UpdateChatUser will update an existing chat user in Twilio based on the provided identifier and options.
+func (twilio *Twilio) UpdateChatUser(serviceSID, userSID string, options UpdateChatUserOptions) (*ChatUser, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.chatURL("Services/"+serviceSID+"/Users/"+url.PathEscape(userSID)), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatUser)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// DeleteChatUser will completely remove the chat user matching the given identifier from within Twilio.
+func (twilio *Twilio) DeleteChatUser(serviceSID, userSID string) error {
+	res, err := twilio.delete(twilio.chatURL("Services/" + serviceSID + "/Users/" + url.PathEscape(userSID)))
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		decoder := json.NewDecoder(res.Body)
+
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return err
+	}
+
+	return nil
+}
+
+// ListChatUserChannels retrieves a single page of the channels that the given chat user has joined or been invited to from Twilio.
+func (twilio *Twilio) ListChatUserChannels(serviceSID, userSID string, options PageOptions) (*ChatUserChannelsResponse, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.get(twilio.chatURL("Services/"+serviceSID+"/Users/"+url.PathEscape(userSID)+"/Channels"), &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatUserChannelsResponse)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// FetchChatUserChannel retrieves a single channel that the given chat user has joined or been invited to from Twilio.
+func (twilio *Twilio) FetchChatUserChannel(serviceSID, userSID, channelSID string) (*ChatUserChannel, error) {
+	res, err := twilio.get(twilio.chatURL("Services/"+serviceSID+"/Users/"+url.PathEscape(userSID)+"/Channels/"+url.PathEscape(channelSID)), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatUserChannel)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// UpdateChatUserChannel will update the notification level or read horizon of the given chat user within the given channel in Twilio.
+func (twilio *Twilio) UpdateChatUserChannel(serviceSID, userSID, channelSID string, options UpdateChatUserChannelOptions) (*ChatUserChannel, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.chatURL("Services/"+serviceSID+"/Users/"+url.PathEscape(userSID)+"/Channels/"+url.PathEscape(channelSID)), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ChatUserChannel)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// DeleteChatUserChannel will remove the given chat user from the given channel, or decline their pending invite to it, within Twilio.
+func (twilio *Twilio) DeleteChatUserChannel(serviceSID, userSID, channelSID string) error {
+	res, err := twilio.delete(twilio.chatURL("Services/" + serviceSID + "/Users/" + url.PathEscape(userSID) + "/Channels/" + url.PathEscape(channelSID)))
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		decoder := json.NewDecoder(res.Body)
+
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return err
+	}
+
+	return nil
+}
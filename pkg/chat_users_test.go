@@ -142,3 +142,221 @@ func TestWillHandleErrorResponsesWhenMakingRequestToCreateNewChatUser(t *testing
 		t.Fail()
 	}
 }
+
+const chatUserChannelResponse = `{
+	"account_sid": "ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"service_sid": "ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"channel_sid": "CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"user_sid": "USXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"member_sid": "MBXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"status": "joined",
+	"last_consumed_message_index": 5,
+	"unread_messages_count": 2,
+	"notification_level": "muted",
+	"links": {
+		"channel": "https://chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+		"member": "https://chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Members/MBXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+	},
+	"url": "https://chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Users/USXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}`
+
+const chatUserBindingsResponse = `{
+	"bindings": [{
+		"sid": "BSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+		"account_sid": "ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+		"service_sid": "ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+		"credential_sid": "CRXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+		"user_sid": "USXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+		"identity": "Unique Name",
+		"endpoint": "TestUser-endpoint",
+		"binding_type": "apn",
+		"message_types": ["removed_from_channel", "new_message"],
+		"date_created": "2020-07-30T00:00:00Z",
+		"date_updated": "2020-07-30T00:00:00Z",
+		"links": {
+			"user": "https://chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Users/USXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+		},
+		"url": "https://chat.twilio.com/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Users/USXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Bindings/BSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+	}],
+	"meta": {"page": 0, "page_size": 50, "next_page_url": null, "key": "bindings"}
+}`
+
+func TestWillMakeRequestToFetchChatUserByIdentitySuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Users/Unique Name"
+
+		if req.Method != http.MethodGet || req.URL.Path != expected {
+			t.Logf("Incorrect request supplied, expecting [GET %s], but received [%s %s]", expected, req.Method, req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(createdChatUserResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.FetchChatUser("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "Unique Name")
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if response.SID != "USXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX" {
+		t.Logf("Incorrect user decoded, received [%v]", response)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToUpdateChatUserSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		if params.Get("FriendlyName") != "Friendly Name" {
+			t.Logf("Incorrect request parameter supplied, expecting [%s], but received [%s]", "Friendly Name", params.Get("FriendlyName"))
+			t.Fail()
+		}
+
+		if _, ok := params["RoleSid"]; ok {
+			t.Log("Empty request parameter RoleSid was incorrectly supplied")
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(createdChatUserResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	_, err := twilio.UpdateChatUser("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "USXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.UpdateChatUserOptions{
+		FriendlyName: "Friendly Name",
+	})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToDeleteChatUserSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Users/USXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+
+		if req.Method != http.MethodDelete || req.URL.Path != expected {
+			t.Logf("Incorrect request supplied, expecting [DELETE %s], but received [%s %s]", expected, req.Method, req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(``)),
+			StatusCode: http.StatusNoContent,
+			Header:     make(http.Header),
+		}
+	})
+
+	err := twilio.DeleteChatUser("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "USXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX")
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToListChatUserChannelsSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Users/USXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Channels"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"channels": [` + chatUserChannelResponse + `], "meta": {"page": 0, "page_size": 50, "key": "channels"}}`)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.ListChatUserChannels("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "USXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.PageOptions{})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if len(response.Channels) != 1 || *response.Channels[0].UnreadMessagesCount != 2 {
+		t.Logf("Incorrect channels decoded, received [%v]", response.Channels)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToUpdateChatUserChannelNotificationLevelSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		if params.Get("NotificationLevel") != "muted" {
+			t.Logf("Incorrect request parameter supplied, expecting [%s], but received [%s]", "muted", params.Get("NotificationLevel"))
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(chatUserChannelResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.UpdateChatUserChannel("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "USXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.UpdateChatUserChannelOptions{
+		NotificationLevel: twiligo.MutedNotifications,
+	})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if response.NotificationLevel != twiligo.MutedNotifications {
+		t.Logf("Incorrect notification level decoded, expecting [%s], but received [%s]", twiligo.MutedNotifications, response.NotificationLevel)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToListChatUserBindingsSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v2/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Users/USXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Bindings"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		if req.URL.Query().Get("BindingType") != "apn" {
+			t.Logf("Incorrect query parameter supplied, expecting [%s], but received [%s]", "apn", req.URL.Query().Get("BindingType"))
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(chatUserBindingsResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.ListChatUserBindings("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "USXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.ListChatUserBindingsOptions{BindingType: []string{"apn"}})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if len(response.Bindings) != 1 || len(response.Bindings[0].MessageTypes) != 2 {
+		t.Logf("Incorrect bindings decoded, received [%v]", response.Bindings)
+		t.Fail()
+	}
+}
@@ -0,0 +1,254 @@
+package twiligo
+
+import (
+	"errors"
+	"net/http"
+	"time"
+)
+
+// ChatEvent is implemented by every typed Chat webhook event returned from ParseChatWebhook.
+type ChatEvent interface {
+	ChatEventType() string
+}
+
+// ChatWebhookEvent holds the fields that Twilio includes with every Chat webhook, regardless of the type of event.
+type ChatWebhookEvent struct {
+	AccountSID     string `json:"AccountSid"`
+	ServiceSID     string `json:"ServiceSid"`
+	EventType      string `json:"EventType"`
+	ClientIdentity string `json:"ClientIdentity"`
+	WebhookSID     string `json:"WebhookSid"`
+	WebhookType    string `json:"WebhookType"`
+	RetryCount     int    `json:"RetryCount"`
+}
+
+// ChatChannelAddedEvent represents the onChannelAdded webhook sent from Twilio after a channel has been created.
+type ChatChannelAddedEvent struct {
+	ChatWebhookEvent
+	ChannelSID   string          `json:"ChannelSid"`
+	ChannelType  ChatChannelType `json:"ChannelType"`
+	FriendlyName string          `json:"FriendlyName"`
+	UniqueName   string          `json:"UniqueName"`
+	Attributes   string          `json:"Attributes"`
+	CreatedBy    string          `json:"CreatedBy"`
+	DateCreated  time.Time       `json:"DateCreated"`
+}
+
+// ChatChannelUpdatedEvent represents the onChannelUpdated webhook sent from Twilio after a channel has been updated.
+type ChatChannelUpdatedEvent struct {
+	ChatWebhookEvent
+	ChannelSID   string          `json:"ChannelSid"`
+	ChannelType  ChatChannelType `json:"ChannelType"`
+	FriendlyName string          `json:"FriendlyName"`
+	UniqueName   string          `json:"UniqueName"`
+	Attributes   string          `json:"Attributes"`
+	CreatedBy    string          `json:"CreatedBy"`
+	DateCreated  time.Time       `json:"DateCreated"`
+	DateUpdated  time.Time       `json:"DateUpdated"`
+}
+
+// ChatChannelDestroyedEvent represents the onChannelDestroyed webhook sent from Twilio after a channel has been deleted.
+type ChatChannelDestroyedEvent struct {
+	ChatWebhookEvent
+	ChannelSID    string          `json:"ChannelSid"`
+	ChannelType   ChatChannelType `json:"ChannelType"`
+	FriendlyName  string          `json:"FriendlyName"`
+	UniqueName    string          `json:"UniqueName"`
+	Attributes    string          `json:"Attributes"`
+	CreatedBy     string          `json:"CreatedBy"`
+	MembersCount  int             `json:"MembersCount"`
+	MessagesCount int             `json:"MessagesCount"`
+	DateCreated   time.Time       `json:"DateCreated"`
+}
+
+// ChatMessageSentEvent represents the onMessageSent webhook sent from Twilio after a text message has been added to a channel.
+type ChatMessageSentEvent struct {
+	ChatWebhookEvent
+	ChannelSID  string    `json:"ChannelSid"`
+	MessageSID  string    `json:"MessageSid"`
+	Index       int       `json:"Index"`
+	From        string    `json:"From"`
+	Body        string    `json:"Body"`
+	Attributes  string    `json:"Attributes"`
+	DateCreated time.Time `json:"DateCreated"`
+}
+
+// ChatMediaMessageSentEvent represents the onMediaMessageSent webhook sent from Twilio after a media message has been added to a channel.
+type ChatMediaMessageSentEvent struct {
+	ChatWebhookEvent
+	ChannelSID       string    `json:"ChannelSid"`
+	MessageSID       string    `json:"MessageSid"`
+	Index            int       `json:"Index"`
+	From             string    `json:"From"`
+	Attributes       string    `json:"Attributes"`
+	MediaSID         string    `json:"MediaSid"`
+	MediaContentType string    `json:"MediaContentType"`
+	MediaFilename    string    `json:"MediaFilename"`
+	MediaSize        int       `json:"MediaSize"`
+	DateCreated      time.Time `json:"DateCreated"`
+}
+
+// ChatMessageUpdatedEvent represents the onMessageUpdated webhook sent from Twilio after a message within a channel has been updated.
+type ChatMessageUpdatedEvent struct {
+	ChatWebhookEvent
+	ChannelSID  string    `json:"ChannelSid"`
+	MessageSID  string    `json:"MessageSid"`
+	Index       int       `json:"Index"`
+	From        string    `json:"From"`
+	Body        string    `json:"Body"`
+	Attributes  string    `json:"Attributes"`
+	ModifiedBy  string    `json:"ModifiedBy"`
+	DateCreated time.Time `json:"DateCreated"`
+	DateUpdated time.Time `json:"DateUpdated"`
+}
+
+// ChatMessageRemovedEvent represents the onMessageRemoved webhook sent from Twilio after a message has been deleted from a channel.
+type ChatMessageRemovedEvent struct {
+	ChatWebhookEvent
+	ChannelSID  string    `json:"ChannelSid"`
+	MessageSID  string    `json:"MessageSid"`
+	Index       int       `json:"Index"`
+	From        string    `json:"From"`
+	Body        string    `json:"Body"`
+	Attributes  string    `json:"Attributes"`
+	ModifiedBy  string    `json:"ModifiedBy"`
+	DateCreated time.Time `json:"DateCreated"`
+	DateUpdated time.Time `json:"DateUpdated"`
+}
+
+// ChatMemberAddedEvent represents the onMemberAdded webhook sent from Twilio after a member has joined or been added to a channel.
+type ChatMemberAddedEvent struct {
+	ChatWebhookEvent
+	ChannelSID  string    `json:"ChannelSid"`
+	MemberSID   string    `json:"MemberSid"`
+	Identity    string    `json:"Identity"`
+	RoleSID     string    `json:"RoleSid"`
+	Reason      string    `json:"Reason"`
+	DateCreated time.Time `json:"DateCreated"`
+}
+
+// ChatMemberUpdatedEvent represents the onMemberUpdated webhook sent from Twilio after a member within a channel has been updated.
+type ChatMemberUpdatedEvent struct {
+	ChatWebhookEvent
+	ChannelSID               string    `json:"ChannelSid"`
+	MemberSID                string    `json:"MemberSid"`
+	Identity                 string    `json:"Identity"`
+	RoleSID                  string    `json:"RoleSid"`
+	Attributes               string    `json:"Attributes"`
+	LastConsumedMessageIndex *int      `json:"LastConsumedMessageIndex"`
+	DateCreated              time.Time `json:"DateCreated"`
+	DateUpdated              time.Time `json:"DateUpdated"`
+}
+
+// ChatMemberRemovedEvent represents the onMemberRemoved webhook sent from Twilio after a member has left or been removed from a channel.
+type ChatMemberRemovedEvent struct {
+	ChatWebhookEvent
+	ChannelSID  string    `json:"ChannelSid"`
+	MemberSID   string    `json:"MemberSid"`
+	Identity    string    `json:"Identity"`
+	Reason      string    `json:"Reason"`
+	DateCreated time.Time `json:"DateCreated"`
+	DateRemoved time.Time `json:"DateRemoved"`
+}
+
+// ChatUserAddedEvent represents the onUserAdded webhook sent from Twilio after a chat user has been created.
+type ChatUserAddedEvent struct {
+	ChatWebhookEvent
+	UserSID      string    `json:"UserSid"`
+	Identity     string    `json:"Identity"`
+	FriendlyName string    `json:"FriendlyName"`
+	Attributes   string    `json:"Attributes"`
+	RoleSID      string    `json:"RoleSid"`
+	DateCreated  time.Time `json:"DateCreated"`
+}
+
+// ChatUserUpdatedEvent represents the onUserUpdated webhook sent from Twilio after a chat user has been updated.
+type ChatUserUpdatedEvent struct {
+	ChatWebhookEvent
+	UserSID      string    `json:"UserSid"`
+	Identity     string    `json:"Identity"`
+	FriendlyName string    `json:"FriendlyName"`
+	Attributes   string    `json:"Attributes"`
+	RoleSID      string    `json:"RoleSid"`
+	IsOnline     bool      `json:"IsOnline"`
+	IsNotifiable bool      `json:"IsNotifiable"`
+	DateCreated  time.Time `json:"DateCreated"`
+	DateUpdated  time.Time `json:"DateUpdated"`
+}
+
+// ChatEventType returns the EventType (e.g. onMessageSent) that the webhook was sent for.
+func (event ChatWebhookEvent) ChatEventType() string {
+	return event.EventType
+}
+
+// ParseChatWebhook decodes the form body of the given Chat webhook request into the typed event matching its EventType, e.g. a *ChatMessageSentEvent for onMessageSent or a *ChatMessageSendEvent for the onMessageSend pre-event.
+func ParseChatWebhook(r *http.Request) (ChatEvent, error) {
+	err := r.ParseForm()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var event ChatEvent
+
+	switch eventType := r.PostForm.Get("EventType"); eventType {
+	case "onChannelAdd":
+		event = new(ChatChannelAddEvent)
+	case "onChannelUpdate":
+		event = new(ChatChannelUpdateEvent)
+	case "onChannelDestroy":
+		event = new(ChatChannelDestroyEvent)
+	case "onMessageSend":
+		event = new(ChatMessageSendEvent)
+	case "onMediaMessageSend":
+		event = new(ChatMediaMessageSendEvent)
+	case "onMessageUpdate":
+		event = new(ChatMessageUpdateEvent)
+	case "onMessageRemove":
+		event = new(ChatMessageRemoveEvent)
+	case "onMemberAdd":
+		event = new(ChatMemberAddEvent)
+	case "onMemberUpdate":
+		event = new(ChatMemberUpdateEvent)
+	case "onMemberRemove":
+		event = new(ChatMemberRemoveEvent)
+	case "onUserUpdate":
+		event = new(ChatUserUpdateEvent)
+	case "onChannelAdded":
+		event = new(ChatChannelAddedEvent)
+	case "onChannelUpdated":
+		event = new(ChatChannelUpdatedEvent)
+	case "onChannelDestroyed":
+		event = new(ChatChannelDestroyedEvent)
+	case "onMessageSent":
+		event = new(ChatMessageSentEvent)
+	case "onMediaMessageSent":
+		event = new(ChatMediaMessageSentEvent)
+	case "onMessageUpdated":
+		event = new(ChatMessageUpdatedEvent)
+	case "onMessageRemoved":
+		event = new(ChatMessageRemovedEvent)
+	case "onMemberAdded":
+		event = new(ChatMemberAddedEvent)
+	case "onMemberUpdated":
+		event = new(ChatMemberUpdatedEvent)
+	case "onMemberRemoved":
+		event = new(ChatMemberRemovedEvent)
+	case "onUserAdded":
+		event = new(ChatUserAddedEvent)
+	case "onUserUpdated":
+		event = new(ChatUserUpdatedEvent)
+	case "":
+		return nil, errors.New("Missing required parameter EventType")
+	default:
+		return nil, errors.New("Unsupported chat webhook EventType " + eventType)
+	}
+
+	err = decodeWebhookValues(r.PostForm, event)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
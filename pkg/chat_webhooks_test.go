@@ -0,0 +1,95 @@
+package twiligo_test
+
+import (
+	"net/url"
+	"testing"
+
+	twiligo "github.com/craigpaul/twiligo/pkg"
+)
+
+func TestWillParseMessageSentChatWebhook(t *testing.T) {
+	req := NewTestWebhookRequest(url.Values{
+		"AccountSid":     {"ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"},
+		"ServiceSid":     {"ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"},
+		"EventType":      {"onMessageSent"},
+		"ClientIdentity": {"jing"},
+		"ChannelSid":     {"CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"},
+		"MessageSid":     {"IMXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"},
+		"Index":          {"3"},
+		"From":           {"jing"},
+		"Body":           {"Hello"},
+		"DateCreated":    {"2020-07-30T00:00:00.000Z"},
+	})
+
+	event, err := twiligo.ParseChatWebhook(req)
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	message, ok := event.(*twiligo.ChatMessageSentEvent)
+
+	if ok == false {
+		t.Logf("Incorrect event returned, expected [%T], but received [%T]", message, event)
+		t.FailNow()
+	}
+
+	if message.ChatEventType() != "onMessageSent" || message.ServiceSID != "ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX" {
+		t.Logf("Incorrect common fields decoded, received [%v]", message.ChatWebhookEvent)
+		t.Fail()
+	}
+
+	if message.Index != 3 || message.Body != "Hello" || message.DateCreated.Year() != 2020 {
+		t.Logf("Incorrect message fields decoded, received [%v]", message)
+		t.Fail()
+	}
+}
+
+func TestWillParseChannelAndMemberChatWebhooks(t *testing.T) {
+	req := NewTestWebhookRequest(url.Values{
+		"EventType":   {"onChannelAdd"},
+		"ChannelType": {"private"},
+		"UniqueName":  {"support"},
+	})
+
+	event, _ := twiligo.ParseChatWebhook(req)
+
+	if channel, ok := event.(*twiligo.ChatChannelAddEvent); ok == false || channel.ChannelType != twiligo.PrivateChatChannel {
+		t.Logf("Incorrect event decoded, received [%v]", event)
+		t.Fail()
+	}
+
+	req = NewTestWebhookRequest(url.Values{
+		"EventType":  {"onMemberAdded"},
+		"ChannelSid": {"CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"},
+		"MemberSid":  {"MBXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"},
+		"Identity":   {"jing"},
+		"Reason":     {"ADDED"},
+	})
+
+	event, _ = twiligo.ParseChatWebhook(req)
+
+	if member, ok := event.(*twiligo.ChatMemberAddedEvent); ok == false || member.Identity != "jing" || member.Reason != "ADDED" {
+		t.Logf("Incorrect event decoded, received [%v]", event)
+		t.Fail()
+	}
+}
+
+func TestWillReturnErrorWhenParsingUnsupportedChatWebhook(t *testing.T) {
+	req := NewTestWebhookRequest(url.Values{"EventType": {"onMessageAdded"}})
+
+	event, err := twiligo.ParseChatWebhook(req)
+
+	if event != nil {
+		t.Logf("Event was incorrectly returned, was not expecting the following event: %v", event)
+		t.Fail()
+	}
+
+	expected := "Unsupported chat webhook EventType onMessageAdded"
+
+	if err == nil || err.Error() != expected {
+		t.Logf("Incorrect error returned, expected [%s], but received [%v]", expected, err)
+		t.Fail()
+	}
+}
@@ -0,0 +1,244 @@
+package twiligo
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+)
+
+// ConversationExportCursor marks the last message that was completely written by ExportConversation, along with the page that message was found on, so that an interrupted export can be resumed.
+type ConversationExportCursor struct {
+	Page         PageOptions `json:"page"`
+	MessageIndex int         `json:"message_index"`
+}
+
+// ConversationExportOptions are all of the options that can be provided to an ExportConversation call.
+type ConversationExportOptions struct {
+	// MediaDirectory is the directory that the content of any media attached to messages is downloaded to. Media is not downloaded when left empty.
+	MediaDirectory string
+	// Cursor resumes a previously interrupted export after the message it marks. The conversation and its participants are not written again.
+	Cursor *ConversationExportCursor
+}
+
+// ConversationExportRecord represents a single line of the JSON Lines stream written by ExportConversation. Type is one of conversation, participant, message, media, delivery_receipt or checkpoint and decides which of the other fields is set.
+type ConversationExportRecord struct {
+	Type            string                       `json:"type"`
+	Conversation    *Conversation                `json:"conversation,omitempty"`
+	Participant     *ConversationParticipant     `json:"participant,omitempty"`
+	Message         *ConversationMessage         `json:"message,omitempty"`
+	Media           *ConversationMedia           `json:"media,omitempty"`
+	MediaPath       string                       `json:"media_path,omitempty"`
+	DeliveryReceipt *ConversationDeliveryReceipt `json:"delivery_receipt,omitempty"`
+	Cursor          *ConversationExportCursor    `json:"cursor,omitempty"`
+}
+
+type conversationExport struct {
+	twilio       *Twilio
+	conversation *Conversation
+	encoder      *json.Encoder
+	options      ConversationExportOptions
+}
+
+// ExportConversation writes the full history of the given conversation to the given writer as JSON Lines: the conversation itself, each of its participants, and then each message in order followed by its media and delivery receipts. A checkpoint record holding a ConversationExportCursor is written after every completed message. If the export is interrupted, the last cursor is returned alongside the error (or nil if no message was completed) and can be provided via the options to resume; any records written after the last checkpoint should be discarded first.
+func (twilio *Twilio) ExportConversation(conversationSID string, w io.Writer, options ConversationExportOptions) (*ConversationExportCursor, error) {
+	conversation, err := twilio.FetchConversation(conversationSID)
+
+	if err != nil {
+		return options.Cursor, err
+	}
+
+	export := &conversationExport{
+		twilio:       twilio,
+		conversation: conversation,
+		encoder:      json.NewEncoder(w),
+		options:      options,
+	}
+
+	if options.Cursor == nil {
+		err = export.writeConversation()
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return export.writeMessages()
+}
+
+func (export *conversationExport) writeConversation() error {
+	err := export.encoder.Encode(ConversationExportRecord{Type: "conversation", Conversation: export.conversation})
+
+	if err != nil {
+		return err
+	}
+
+	options := PageOptions{}
+
+	for {
+		response, err := export.twilio.ListConversationParticipants(export.conversation.SID, options)
+
+		if err != nil {
+			return err
+		}
+
+		for _, participant := range response.Participants {
+			err = export.encoder.Encode(ConversationExportRecord{Type: "participant", Participant: participant})
+
+			if err != nil {
+				return err
+			}
+		}
+
+		next := response.Meta.NextPage()
+
+		if next == nil {
+			return nil
+		}
+
+		options = *next
+	}
+}
+
+func (export *conversationExport) writeMessages() (*ConversationExportCursor, error) {
+	cursor := export.options.Cursor
+	page := PageOptions{}
+
+	if cursor != nil {
+		page = cursor.Page
+	}
+
+	for {
+		response, err := export.twilio.ListConversationMessages(export.conversation.SID, page)
+
+		if err != nil {
+			return cursor, err
+		}
+
+		for _, message := range response.Messages {
+			if cursor != nil && message.Index <= cursor.MessageIndex {
+				continue
+			}
+
+			err = export.writeMessage(message)
+
+			if err != nil {
+				return cursor, err
+			}
+
+			cursor = &ConversationExportCursor{Page: page, MessageIndex: message.Index}
+
+			err = export.encoder.Encode(ConversationExportRecord{Type: "checkpoint", Cursor: cursor})
+
+			if err != nil {
+				return cursor, err
+			}
+		}
+
+		next := response.Meta.NextPage()
+
+		if next == nil {
+			return cursor, nil
+		}
+
+		page = *next
+	}
+}
+
+func (export *conversationExport) writeMessage(message *ConversationMessage) error {
+	err := export.encoder.Encode(ConversationExportRecord{Type: "message", Message: message})
+
+	if err != nil {
+		return err
+	}
+
+	for _, attached := range message.Media {
+		media, err := export.twilio.fetchConversationMedia(export.conversation.ChatServiceSID, attached.SID)
+
+		if err != nil {
+			return err
+		}
+
+		record := ConversationExportRecord{Type: "media", Media: media}
+
+		if export.options.MediaDirectory != "" {
+			record.MediaPath, err = export.downloadMedia(media)
+
+			if err != nil {
+				return err
+			}
+		}
+
+		err = export.encoder.Encode(record)
+
+		if err != nil {
+			return err
+		}
+	}
+
+	if message.Delivery == nil {
+		return nil
+	}
+
+	options := PageOptions{}
+
+	for {
+		response, err := export.twilio.ListConversationDeliveryReceipts(export.conversation.SID, message.SID, options)
+
+		if err != nil {
+			return err
+		}
+
+		for _, receipt := range response.DeliveryReceipts {
+			err = export.encoder.Encode(ConversationExportRecord{Type: "delivery_receipt", DeliveryReceipt: receipt})
+
+			if err != nil {
+				return err
+			}
+		}
+
+		next := response.Meta.NextPage()
+
+		if next == nil {
+			return nil
+		}
+
+		options = *next
+	}
+}
+
+func (export *conversationExport) downloadMedia(media *ConversationMedia) (string, error) {
+	name := media.SID
+
+	if media.Filename != nil {
+		name += filepath.Ext(*media.Filename)
+	}
+
+	content, err := export.twilio.downloadConversationMedia(media)
+
+	if err != nil {
+		return "", err
+	}
+
+	defer content.Close()
+
+	path := filepath.Join(export.options.MediaDirectory, name)
+
+	file, err := os.Create(path)
+
+	if err != nil {
+		return "", err
+	}
+
+	_, err = io.Copy(file, content)
+
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return path, nil
+}
@@ -0,0 +1,241 @@
+package twiligo_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	twiligo "github.com/craigpaul/twiligo/pkg"
+)
+
+const conversationParticipantsResponse = `{
+	"participants": [` + createdConversationParticipantResponse + `],
+	"meta": {"page": 0, "page_size": 50, "next_page_url": null, "key": "participants"}
+}`
+
+const conversationDeliveryReceiptsResponse = `{
+	"delivery_receipts": [
+		{
+			"sid": "DYXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"account_sid": "ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"conversation_sid": "CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"message_sid": "IMXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"channel_message_sid": "SMXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"participant_sid": "MBXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"status": "delivered",
+			"error_code": null,
+			"date_created": "2020-07-30T00:00:00Z",
+			"date_updated": "2020-07-30T00:00:00Z",
+			"url": "https://conversations.twilio.com/v1/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Messages/IMXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Receipts/DYXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+		}
+	],
+	"meta": {"page": 0, "page_size": 50, "next_page_url": null, "key": "delivery_receipts"}
+}`
+
+var firstConversationMessagesPageResponse = `{
+	"messages": [` + strings.Replace(conversationMessageResponse, `"delivery": null`, `"delivery": {"total": 1, "sent": "all", "delivered": "all", "read": "none", "failed": "none", "undelivered": "none"}`, 1) + `],
+	"meta": {
+		"page": 0,
+		"page_size": 1,
+		"next_page_url": "https://conversations.twilio.com/v1/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Messages?PageSize=1&Page=1&PageToken=PT1",
+		"key": "messages"
+	}
+}`
+
+const secondConversationMessagesPageResponse = `{
+	"messages": [
+		{
+			"sid": "IMYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY",
+			"account_sid": "ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"conversation_sid": "CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"index": 1,
+			"author": "+15555555555",
+			"body": "Thanks",
+			"media": null,
+			"attributes": "{}",
+			"participant_sid": "MBXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"delivery": null,
+			"date_created": "2020-07-30T00:00:00Z",
+			"date_updated": "2020-07-30T00:00:00Z",
+			"url": "https://conversations.twilio.com/v1/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Messages/IMYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY"
+		}
+	],
+	"meta": {"page": 1, "page_size": 1, "next_page_url": null, "key": "messages"}
+}`
+
+func ExportRecordTypes(t *testing.T, buffer *bytes.Buffer) []string {
+	types := []string{}
+
+	scanner := bufio.NewScanner(buffer)
+
+	for scanner.Scan() {
+		record := twiligo.ConversationExportRecord{}
+
+		err := json.Unmarshal(scanner.Bytes(), &record)
+
+		if err != nil {
+			t.Logf("Invalid JSON line written, received [%s]", scanner.Text())
+			t.Fail()
+		}
+
+		types = append(types, record.Type)
+	}
+
+	return types
+}
+
+func TestWillExportConversationHistoryAsJSONLines(t *testing.T) {
+	requests := []string{}
+	buffer := new(bytes.Buffer)
+	directory := t.TempDir()
+
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		requests = append(requests, req.URL.Host+req.URL.Path)
+
+		body := ""
+
+		switch req.URL.Host + req.URL.Path {
+		case "conversations.twilio.com/v1/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX":
+			body = createdConversationResponse
+		case "conversations.twilio.com/v1/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Participants":
+			body = conversationParticipantsResponse
+		case "conversations.twilio.com/v1/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Messages":
+			body = firstConversationMessagesPageResponse
+
+			if req.URL.Query().Get("PageToken") == "PT1" {
+				body = secondConversationMessagesPageResponse
+			}
+		case "conversations.twilio.com/v1/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Messages/IMXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Receipts":
+			body = conversationDeliveryReceiptsResponse
+		case "mcs.us1.twilio.com/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Media/MEXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX":
+			body = conversationMediaResponse
+		case "media.us1.twilio.com/MEXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX":
+			body = "\x89PNG"
+		default:
+			t.Logf("Request was incorrectly made, was not expecting the following request: %s", req.URL)
+			t.FailNow()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	cursor, err := twilio.ExportConversation("CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", buffer, twiligo.ConversationExportOptions{MediaDirectory: directory})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if cursor == nil || cursor.MessageIndex != 1 || cursor.Page.PageToken != "PT1" {
+		t.Logf("Incorrect cursor returned, received [%v]", cursor)
+		t.Fail()
+	}
+
+	media, err := ioutil.ReadFile(directory + "/MEXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX.png")
+
+	if err != nil || string(media) != "\x89PNG" {
+		t.Logf("Media content was not downloaded, received [%q] (%v)", media, err)
+		t.Fail()
+	}
+
+	expected := []string{"conversation", "participant", "message", "media", "delivery_receipt", "checkpoint", "message", "checkpoint"}
+	types := ExportRecordTypes(t, buffer)
+
+	if strings.Join(types, ",") != strings.Join(expected, ",") {
+		t.Logf("Incorrect records written, expected [%v], but received [%v]", expected, types)
+		t.Fail()
+	}
+}
+
+func TestWillResumeExportingConversationHistoryFromCursor(t *testing.T) {
+	requests := []string{}
+	buffer := new(bytes.Buffer)
+
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		requests = append(requests, req.URL.Host+req.URL.Path)
+
+		body := createdConversationResponse
+
+		switch {
+		case strings.HasSuffix(req.URL.Path, "/Messages") && req.URL.Query().Get("PageToken") == "PT1":
+			body = secondConversationMessagesPageResponse
+		case strings.HasSuffix(req.URL.Path, "/Messages"):
+			body = firstConversationMessagesPageResponse
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	_, err := twilio.ExportConversation("CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", buffer, twiligo.ConversationExportOptions{
+		Cursor: &twiligo.ConversationExportCursor{MessageIndex: 0},
+	})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	expected := []string{"message", "checkpoint"}
+	types := ExportRecordTypes(t, buffer)
+
+	if strings.Join(types, ",") != strings.Join(expected, ",") {
+		t.Logf("Incorrect records written, expected [%v], but received [%v]", expected, types)
+		t.Fail()
+	}
+
+	for _, request := range requests {
+		if strings.HasSuffix(request, "/Participants") || strings.HasPrefix(request, "mcs.") {
+			t.Logf("Request was incorrectly made when resuming, was not expecting the following request: %s", request)
+			t.Fail()
+		}
+	}
+}
+
+func TestWillReturnLastCursorWhenExportingConversationHistoryIsInterrupted(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		body, status := firstConversationMessagesPageResponse, http.StatusOK
+
+		switch {
+		case strings.HasSuffix(req.URL.Path, "/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"):
+			body = createdConversationResponse
+		case strings.HasSuffix(req.URL.Path, "/Receipts"):
+			body = conversationDeliveryReceiptsResponse
+		case strings.HasPrefix(req.URL.Path, "/v1/Services/"):
+			body = conversationMediaResponse
+		case req.URL.Query().Get("PageToken") == "PT1":
+			body, status = `{"code": 20500, "message": "Internal Server Error", "status": 500}`, http.StatusInternalServerError
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(body)),
+			StatusCode: status,
+			Header:     make(http.Header),
+		}
+	})
+
+	cursor, err := twilio.ExportConversation("CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", ioutil.Discard, twiligo.ConversationExportOptions{
+		Cursor: &twiligo.ConversationExportCursor{MessageIndex: -1},
+	})
+
+	if err == nil || err.Error() != "Internal Server Error" {
+		t.Logf("Incorrect error returned, expected [%s], but received [%v]", "Internal Server Error", err)
+		t.Fail()
+	}
+
+	if cursor == nil || cursor.MessageIndex != 0 || cursor.Page.PageToken != "" {
+		t.Logf("Incorrect cursor returned, received [%v]", cursor)
+		t.Fail()
+	}
+}
@@ -0,0 +1,138 @@
+package twiligo
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+)
+
+// ConversationMedia represents a single file that has been uploaded to the Media Content Service so that it can be attached to a ConversationMessage.
+type ConversationMedia struct {
+	SID                 string     `json:"sid"`
+	AccountSID          string     `json:"account_sid"`
+	ServiceSID          string     `json:"service_sid"`
+	ChannelSID          *string    `json:"channel_sid"`
+	MessageSID          *string    `json:"message_sid"`
+	Author              string     `json:"author"`
+	Category            string     `json:"category"`
+	ContentType         string     `json:"content_type"`
+	Filename            *string    `json:"filename"`
+	Size                int        `json:"size"`
+	IsMultipartUpstream bool       `json:"is_multipart_upstream"`
+	DateCreated         time.Time  `json:"date_created"`
+	DateUpdated         time.Time  `json:"date_updated"`
+	DateUploadCompleted *time.Time `json:"date_upload_completed"`
+	Links               struct {
+		Content                string `json:"content"`
+		ContentDirectTemporary string `json:"content_direct_temporary"`
+	} `json:"links"`
+	URL string `json:"url"`
+}
+
+// UploadConversationMedia uploads the contents of the given reader to the Media Content Service of the current Conversation Service (see WithConversationService). The SID of the returned media can then be provided as the MediaSID when creating a new conversation message.
+func (twilio *Twilio) UploadConversationMedia(contentType, filename string, media io.Reader) (*ConversationMedia, error) {
+	return twilio.uploadConversationMedia(twilio.ConversationServiceSID, contentType, filename, media)
+}
+
+// FetchConversationMedia retrieves the media matching the given identifier from the Media Content Service of the current Conversation Service.
+func (twilio *Twilio) FetchConversationMedia(mediaSID string) (*ConversationMedia, error) {
+	return twilio.fetchConversationMedia(twilio.ConversationServiceSID, mediaSID)
+}
+
+// FetchConversationMediaURL retrieves a temporary, pre-signed URL from which the content of the media matching the given identifier can be downloaded without credentials. The URL expires shortly after it is issued, so it should be fetched again rather than stored.
+func (twilio *Twilio) FetchConversationMediaURL(mediaSID string) (string, error) {
+	media, err := twilio.FetchConversationMedia(mediaSID)
+
+	if err != nil {
+		return "", err
+	}
+
+	return media.Links.ContentDirectTemporary, nil
+}
+
+func (twilio *Twilio) uploadConversationMedia(serviceSID, contentType, filename string, media io.Reader) (*ConversationMedia, error) {
+	resource, err := twilio.mediaURL(serviceSID, "Media")
+
+	if err != nil {
+		return nil, err
+	}
+
+	if filename != "" {
+		resource += "?" + url.Values{"Filename": {filename}}.Encode()
+	}
+
+	res, err := twilio.postBody(resource, contentType, media)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusCreated {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ConversationMedia)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+func (twilio *Twilio) fetchConversationMedia(serviceSID, mediaSID string) (*ConversationMedia, error) {
+	resource, err := twilio.mediaURL(serviceSID, "Media/"+mediaSID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.get(resource, nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ConversationMedia)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+func (twilio *Twilio) downloadConversationMedia(media *ConversationMedia) (io.ReadCloser, error) {
+	res, err := twilio.HTTPClient.Get(media.Links.ContentDirectTemporary)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if res.StatusCode != http.StatusOK {
+		res.Body.Close()
+
+		return nil, errors.New("Unable to download the content of media " + media.SID)
+	}
+
+	return res.Body, nil
+}
@@ -0,0 +1,159 @@
+package twiligo_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+)
+
+const conversationMediaResponse = `{
+	"sid": "MEXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"account_sid": "ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"service_sid": "ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"channel_sid": null,
+	"message_sid": null,
+	"author": "system",
+	"category": "media",
+	"content_type": "image/png",
+	"filename": "receipt.png",
+	"size": 4,
+	"is_multipart_upstream": false,
+	"date_created": "2020-07-30T00:00:00Z",
+	"date_updated": "2020-07-30T00:00:00Z",
+	"date_upload_completed": "2020-07-30T00:00:00Z",
+	"links": {
+		"content": "/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Media/MEXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Content",
+		"content_direct_temporary": "https://media.us1.twilio.com/MEXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX?Expires=1596067200&Signature=signature"
+	},
+	"url": "/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Media/MEXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}`
+
+func TestWillMakeRequestToUploadConversationMediaSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "mcs.us1.twilio.com/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Media"
+
+		if req.URL.Host+req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Host+req.URL.Path)
+			t.Fail()
+		}
+
+		if req.URL.Query().Get("Filename") != "receipt.png" {
+			t.Logf("Incorrect query parameter supplied, expecting [%s], but received [%s]", "receipt.png", req.URL.Query().Get("Filename"))
+			t.Fail()
+		}
+
+		if req.Header.Get("Authorization") == "" {
+			t.Log("Missing authorization credentials, they should be supplied via the Authorization header")
+			t.Fail()
+		}
+
+		if req.Header.Get("Content-Type") != "image/png" {
+			t.Logf("Incorrect content-type header supplied, expecting [%s], but received [%s]", "image/png", req.Header.Get("Content-Type"))
+			t.Fail()
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+
+		if string(body) != "\x89PNG" {
+			t.Logf("Incorrect request body supplied, expecting the raw media but received [%q]", body)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(conversationMediaResponse)),
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+		}
+	}).WithConversationService("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX")
+
+	response, err := twilio.UploadConversationMedia("image/png", "receipt.png", strings.NewReader("\x89PNG"))
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if response.SID != "MEXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX" || response.Size != 4 {
+		t.Logf("Incorrect media decoded, received [%v]", response)
+		t.Fail()
+	}
+}
+
+func TestWillNotMakeRequestToUploadConversationMediaWithoutConversationService(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		t.Logf("Request was incorrectly made, was not expecting the following request: %v", req)
+		t.FailNow()
+
+		return &http.Response{}
+	})
+
+	response, err := twilio.UploadConversationMedia("image/png", "receipt.png", strings.NewReader("\x89PNG"))
+
+	if response != nil {
+		t.Logf("Response was incorrectly returned, was not expecting the following response: %v", response)
+		t.Fail()
+	}
+
+	expected := "Missing required parameter ConversationServiceSID"
+
+	if err == nil || err.Error() != expected {
+		t.Logf("Incorrect error returned, expected [%s], but received [%v]", expected, err)
+		t.Fail()
+	}
+}
+
+func TestWillNotMakeRequestToFetchConversationMediaWithoutConversationService(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		t.Logf("Request was incorrectly made, was not expecting the following request: %v", req)
+		t.FailNow()
+
+		return &http.Response{}
+	})
+
+	response, err := twilio.FetchConversationMedia("MEXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX")
+
+	if response != nil {
+		t.Logf("Response was incorrectly returned, was not expecting the following response: %v", response)
+		t.Fail()
+	}
+
+	expected := "Missing required parameter ConversationServiceSID"
+
+	if err == nil || err.Error() != expected {
+		t.Logf("Incorrect error returned, expected [%s], but received [%v]", expected, err)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToFetchConversationMediaURLSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Media/MEXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+
+		if req.Method != http.MethodGet || req.URL.Path != expected {
+			t.Logf("Incorrect request supplied, expecting [GET %s], but received [%s %s]", expected, req.Method, req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(conversationMediaResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	}).WithConversationService("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX")
+
+	mediaURL, err := twilio.FetchConversationMediaURL("MEXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX")
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	expected := "https://media.us1.twilio.com/MEXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX?Expires=1596067200&Signature=signature"
+
+	if mediaURL != expected {
+		t.Logf("Incorrect media URL returned, expected [%s], but received [%s]", expected, mediaURL)
+		t.Fail()
+	}
+}
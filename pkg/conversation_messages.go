@@ -0,0 +1,278 @@
+package twiligo
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+// ConversationMessage represents a single message, with an optional body and media, sent within a Conversation.
+type ConversationMessage struct {
+	SID             string                      `json:"sid"`
+	AccountSID      string                      `json:"account_sid"`
+	ConversationSID string                      `json:"conversation_sid"`
+	Index           int                         `json:"index"`
+	Author          string                      `json:"author"`
+	Body            *string                     `json:"body"`
+	Media           []*ConversationMessageMedia `json:"media"`
+	Attributes      string                      `json:"attributes"`
+	ParticipantSID  *string                     `json:"participant_sid"`
+	Delivery        *struct {
+		Total       int    `json:"total"`
+		Sent        string `json:"sent"`
+		Delivered   string `json:"delivered"`
+		Read        string `json:"read"`
+		Failed      string `json:"failed"`
+		Undelivered string `json:"undelivered"`
+	} `json:"delivery"`
+	DateCreated time.Time `json:"date_created"`
+	DateUpdated time.Time `json:"date_updated"`
+	Links       struct {
+		DeliveryReceipts string `json:"delivery_receipts"`
+	} `json:"links"`
+	URL string `json:"url"`
+}
+
+// ConversationDeliveryReceipt represents the delivery status of a single ConversationMessage to a single non-chat participant (SMS, WhatsApp, etc.).
+type ConversationDeliveryReceipt struct {
+	SID               string    `json:"sid"`
+	AccountSID        string    `json:"account_sid"`
+	ConversationSID   string    `json:"conversation_sid"`
+	MessageSID        string    `json:"message_sid"`
+	ChannelMessageSID string    `json:"channel_message_sid"`
+	ParticipantSID    string    `json:"participant_sid"`
+	Status            string    `json:"status"`
+	ErrorCode         *int      `json:"error_code"`
+	DateCreated       time.Time `json:"date_created"`
+	DateUpdated       time.Time `json:"date_updated"`
+	URL               string    `json:"url"`
+}
+
+// ConversationDeliveryReceiptsResponse is the representation of the JSON response from Twilio when listing the delivery receipts of a message.
+type ConversationDeliveryReceiptsResponse struct {
+	DeliveryReceipts []*ConversationDeliveryReceipt `json:"delivery_receipts"`
+	Meta             Meta                           `json:"meta"`
+}
+
+// ConversationMessageMedia describes a single piece of media attached to a ConversationMessage.
+type ConversationMessageMedia struct {
+	SID         string  `json:"sid"`
+	Category    string  `json:"category"`
+	ContentType string  `json:"content_type"`
+	Filename    *string `json:"filename"`
+	Size        int     `json:"size"`
+}
+
+// ConversationMessageOptions are all of the options that can be provided to a CreateNewConversationMessage or UpdateConversationMessage call. Note: MediaSID can only be provided when creating a new message.
+type ConversationMessageOptions struct {
+	Author      string    `url:",omitempty"`
+	Body        string    `url:",omitempty"`
+	MediaSID    string    `url:"MediaSid,omitempty"`
+	Attributes  string    `url:",omitempty"`
+	DateCreated time.Time `url:",omitempty"`
+	DateUpdated time.Time `url:",omitempty"`
+}
+
+// ConversationMessagesResponse is the representation of the JSON response from Twilio when listing the messages of a conversation.
+type ConversationMessagesResponse struct {
+	Messages []*ConversationMessage `json:"messages"`
+	Meta     Meta                   `json:"meta"`
+}
+
+// CreateNewConversationMessage adds a new message to the given conversation in Twilio.
+func (twilio *Twilio) CreateNewConversationMessage(conversationSID string, options ConversationMessageOptions) (*ConversationMessage, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.scopedConversationURL("Conversations/"+conversationSID+"/Messages"), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusCreated {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ConversationMessage)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// CreateNewConversationMediaMessage uploads the contents of the given reader to the Media Content Service and then adds a new message with that media attached to the given conversation in Twilio.
+func (twilio *Twilio) CreateNewConversationMediaMessage(conversationSID, contentType, filename string, media io.Reader, options ConversationMessageOptions) (*ConversationMessage, error) {
+	uploaded, err := twilio.UploadConversationMedia(contentType, filename, media)
+
+	if err != nil {
+		return nil, err
+	}
+
+	options.MediaSID = uploaded.SID
+
+	return twilio.CreateNewConversationMessage(conversationSID, options)
+}
+
+// FetchConversationMessage retrieves the message matching the given identifier from the given conversation in Twilio.
+func (twilio *Twilio) FetchConversationMessage(conversationSID, messageSID string) (*ConversationMessage, error) {
+	res, err := twilio.get(twilio.scopedConversationURL("Conversations/"+conversationSID+"/Messages/"+messageSID), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ConversationMessage)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// ListConversationMessages retrieves a single page of the messages within the given conversation from Twilio.
+func (twilio *Twilio) ListConversationMessages(conversationSID string, options PageOptions) (*ConversationMessagesResponse, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.get(twilio.scopedConversationURL("Conversations/"+conversationSID+"/Messages"), &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ConversationMessagesResponse)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// UpdateConversationMessage will update an existing message within the given conversation in Twilio based on the provided identifier and options.
+func (twilio *Twilio) UpdateConversationMessage(conversationSID, messageSID string, options ConversationMessageOptions) (*ConversationMessage, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.scopedConversationURL("Conversations/"+conversationSID+"/Messages/"+messageSID), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ConversationMessage)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// DeleteConversationMessage will remove the message matching the given identifier from the given conversation within Twilio.
+func (twilio *Twilio) DeleteConversationMessage(conversationSID, messageSID string) error {
+	res, err := twilio.delete(twilio.scopedConversationURL("Conversations/" + conversationSID + "/Messages/" + messageSID))
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		decoder := json.NewDecoder(res.Body)
+
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return err
+	}
+
+	return nil
+}
+
+// ListConversationDeliveryReceipts retrieves a single page of the delivery receipts for the given message within the given conversation from Twilio.
+func (twilio *Twilio) ListConversationDeliveryReceipts(conversationSID, messageSID string, options PageOptions) (*ConversationDeliveryReceiptsResponse, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.get(twilio.scopedConversationURL("Conversations/"+conversationSID+"/Messages/"+messageSID+"/Receipts"), &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ConversationDeliveryReceiptsResponse)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
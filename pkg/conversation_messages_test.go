@@ -0,0 +1,147 @@
+package twiligo_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	twiligo "github.com/craigpaul/twiligo/pkg"
+)
+
+const conversationMessageResponse = `{
+	"sid": "IMXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"account_sid": "ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"conversation_sid": "CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"index": 0,
+	"author": "agent",
+	"body": null,
+	"media": [
+		{
+			"sid": "MEXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"category": "media",
+			"content_type": "image/png",
+			"filename": "receipt.png",
+			"size": 4
+		}
+	],
+	"attributes": "{}",
+	"participant_sid": null,
+	"delivery": null,
+	"date_created": "2020-07-30T00:00:00Z",
+	"date_updated": "2020-07-30T00:00:00Z",
+	"links": {
+		"delivery_receipts": "https://conversations.twilio.com/v1/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Messages/IMXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Receipts"
+	},
+	"url": "https://conversations.twilio.com/v1/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Messages/IMXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}`
+
+func TestWillMakeRequestToCreateNewConversationMessageSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Messages"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		if req.Header.Get("Content-Type") != "application/x-www-form-urlencoded" {
+			t.Logf("Incorrect content-type header supplied, expecting [%s], but received [%s]", "application/x-www-form-urlencoded", req.Header.Get("Content-Type"))
+			t.Fail()
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		if params.Get("MediaSid") != "MEXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX" {
+			t.Logf("Incorrect request parameter supplied, expecting [%s], but received [%s]", "MEXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", params.Get("MediaSid"))
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(conversationMessageResponse)),
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.CreateNewConversationMessage("CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.ConversationMessageOptions{
+		Author:   "agent",
+		MediaSID: "MEXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if len(response.Media) != 1 || response.Media[0].SID != "MEXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX" {
+		t.Logf("Incorrect media decoded, received [%v]", response.Media)
+		t.Fail()
+	}
+}
+
+func TestWillUploadMediaBeforeCreatingNewConversationMediaMessage(t *testing.T) {
+	requests := []string{}
+
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		requests = append(requests, req.Method+" "+req.URL.Host+req.URL.Path)
+
+		if req.URL.Host == "mcs.us1.twilio.com" {
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(conversationMediaResponse)),
+				StatusCode: http.StatusCreated,
+				Header:     make(http.Header),
+			}
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(conversationMessageResponse)),
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+		}
+	}).WithConversationService("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX")
+
+	_, err := twilio.CreateNewConversationMediaMessage("CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "image/png", "receipt.png", strings.NewReader("\x89PNG"), twiligo.ConversationMessageOptions{})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	expected := []string{
+		"POST mcs.us1.twilio.com/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Media",
+		"POST conversations.twilio.com/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Messages",
+	}
+
+	if strings.Join(requests, ",") != strings.Join(expected, ",") {
+		t.Logf("Incorrect requests made, expected [%v], but received [%v]", expected, requests)
+		t.Fail()
+	}
+}
+
+func TestWillHandleErrorResponsesWhenMakingRequestToCreateNewConversationMessage(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(attributesAreJsonResponse)),
+			StatusCode: http.StatusBadRequest,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.CreateNewConversationMessage("CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.ConversationMessageOptions{Attributes: "invalid"})
+
+	if response != nil {
+		t.Logf("Response was incorrectly returned, was not expecting the following response: %v", response)
+		t.Fail()
+	}
+
+	expected := "Attributes not valid JSON"
+
+	if err == nil || err.Error() != expected {
+		t.Logf("Incorrect error returned, expected [%s], but received [%v]", expected, err)
+		t.Fail()
+	}
+}
@@ -0,0 +1,292 @@
+package twiligo
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"regexp"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+// DuplicateParticipantBindingErrorCode is the error code returned by Twilio when a participant's messaging binding (address and proxy address) is already in use by another conversation.
+const DuplicateParticipantBindingErrorCode = 50416
+
+var conversationSIDPattern = regexp.MustCompile(`CH[0-9a-zA-Z]{32}`)
+
+// ConversationParticipant represents a single chat or messaging (SMS, WhatsApp, etc.) participant within a Conversation.
+type ConversationParticipant struct {
+	SID                  string                       `json:"sid"`
+	AccountSID           string                       `json:"account_sid"`
+	ConversationSID      string                       `json:"conversation_sid"`
+	Identity             *string                      `json:"identity"`
+	Attributes           string                       `json:"attributes"`
+	MessagingBinding     *ParticipantMessagingBinding `json:"messaging_binding"`
+	RoleSID              string                       `json:"role_sid"`
+	LastReadMessageIndex *int                         `json:"last_read_message_index"`
+	LastReadTimestamp    *string                      `json:"last_read_timestamp"`
+	DateCreated          time.Time                    `json:"date_created"`
+	DateUpdated          time.Time                    `json:"date_updated"`
+	URL                  string                       `json:"url"`
+}
+
+// ConversationParticipantOptions are all of the options that can be provided to a CreateNewConversationParticipant or UpdateConversationParticipant call.
+type ConversationParticipantOptions struct {
+	Identity                         string    `url:",omitempty"`
+	MessagingBindingAddress          string    `url:"MessagingBinding.Address,omitempty"`
+	MessagingBindingProxyAddress     string    `url:"MessagingBinding.ProxyAddress,omitempty"`
+	MessagingBindingProjectedAddress string    `url:"MessagingBinding.ProjectedAddress,omitempty"`
+	Attributes                       string    `url:",omitempty"`
+	RoleSID                          string    `url:"RoleSid,omitempty"`
+	DateCreated                      time.Time `url:",omitempty"`
+	DateUpdated                      time.Time `url:",omitempty"`
+}
+
+// ConversationParticipantsResponse is the representation of the JSON response from Twilio when listing the participants of a conversation.
+type ConversationParticipantsResponse struct {
+	Participants []*ConversationParticipant `json:"participants"`
+	Meta         Meta                       `json:"meta"`
+}
+
+// ListParticipantConversationsOptions are all of the options that can be provided to a ListParticipantConversations call.
+type ListParticipantConversationsOptions struct {
+	PageOptions
+	Identity string `url:",omitempty"`
+	Address  string `url:",omitempty"`
+}
+
+// ParticipantConversation represents a single Conversation that a given participant, identified by either their Identity or messaging Address, belongs to.
+type ParticipantConversation struct {
+	AccountSID                  string                       `json:"account_sid"`
+	ChatServiceSID              string                       `json:"chat_service_sid"`
+	ParticipantSID              string                       `json:"participant_sid"`
+	ParticipantUserSID          *string                      `json:"participant_user_sid"`
+	ParticipantIdentity         *string                      `json:"participant_identity"`
+	ParticipantMessagingBinding *ParticipantMessagingBinding `json:"participant_messaging_binding"`
+	ConversationSID             string                       `json:"conversation_sid"`
+	ConversationUniqueName      *string                      `json:"conversation_unique_name"`
+	ConversationFriendlyName    *string                      `json:"conversation_friendly_name"`
+	ConversationAttributes      string                       `json:"conversation_attributes"`
+	ConversationDateCreated     time.Time                    `json:"conversation_date_created"`
+	ConversationDateUpdated     time.Time                    `json:"conversation_date_updated"`
+	ConversationCreatedBy       string                       `json:"conversation_created_by"`
+	ConversationState           ConversationState            `json:"conversation_state"`
+	ConversationTimers          struct {
+		DateInactive *time.Time `json:"date_inactive"`
+		DateClosed   *time.Time `json:"date_closed"`
+	} `json:"conversation_timers"`
+	Links struct {
+		Participant  string `json:"participant"`
+		Conversation string `json:"conversation"`
+	} `json:"links"`
+}
+
+// ParticipantConversationsResponse is the representation of the JSON response from Twilio when listing the conversations of a given participant.
+type ParticipantConversationsResponse struct {
+	Conversations []*ParticipantConversation `json:"conversations"`
+	Meta          Meta                       `json:"meta"`
+}
+
+// ParticipantMessagingBinding describes how a non-chat participant (SMS, WhatsApp, etc.) is connected to a Conversation.
+type ParticipantMessagingBinding struct {
+	Type             string  `json:"type"`
+	Address          string  `json:"address"`
+	ProxyAddress     string  `json:"proxy_address"`
+	ProjectedAddress *string `json:"projected_address"`
+}
+
+// CreateNewConversationParticipant adds a new participant to the given conversation in Twilio.
+func (twilio *Twilio) CreateNewConversationParticipant(conversationSID string, options ConversationParticipantOptions) (*ConversationParticipant, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.scopedConversationURL("Conversations/"+conversationSID+"/Participants"), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusCreated {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ConversationParticipant)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// FetchConversationParticipant retrieves the participant matching the given identifier from the given conversation in Twilio.
+func (twilio *Twilio) FetchConversationParticipant(conversationSID, participantSID string) (*ConversationParticipant, error) {
+	res, err := twilio.get(twilio.scopedConversationURL("Conversations/"+conversationSID+"/Participants/"+participantSID), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ConversationParticipant)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// ListConversationParticipants retrieves a single page of the participants within the given conversation from Twilio.
+func (twilio *Twilio) ListConversationParticipants(conversationSID string, options PageOptions) (*ConversationParticipantsResponse, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.get(twilio.scopedConversationURL("Conversations/"+conversationSID+"/Participants"), &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ConversationParticipantsResponse)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// UpdateConversationParticipant will update an existing participant within the given conversation in Twilio based on the provided identifier and options.
+func (twilio *Twilio) UpdateConversationParticipant(conversationSID, participantSID string, options ConversationParticipantOptions) (*ConversationParticipant, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.scopedConversationURL("Conversations/"+conversationSID+"/Participants/"+participantSID), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ConversationParticipant)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// DeleteConversationParticipant will remove the participant matching the given identifier from the given conversation within Twilio.
+func (twilio *Twilio) DeleteConversationParticipant(conversationSID, participantSID string) error {
+	res, err := twilio.delete(twilio.scopedConversationURL("Conversations/" + conversationSID + "/Participants/" + participantSID))
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		decoder := json.NewDecoder(res.Body)
+
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return err
+	}
+
+	return nil
+}
+
+// ListParticipantConversations retrieves a single page of the conversations that the participant matching the given Identity or Address belongs to from Twilio.
+func (twilio *Twilio) ListParticipantConversations(options ListParticipantConversationsOptions) (*ParticipantConversationsResponse, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.get(twilio.scopedConversationURL("ParticipantConversations"), &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ParticipantConversationsResponse)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// ExistingConversationSID extracts the identifier of the conversation already using a participant's messaging binding from the error returned by Twilio when attempting to add that participant elsewhere.
+func ExistingConversationSID(err error) (string, bool) {
+	var exception *Exception
+
+	if errors.As(err, &exception) == false || exception.Code != DuplicateParticipantBindingErrorCode {
+		return "", false
+	}
+
+	conversationSID := conversationSIDPattern.FindString(exception.Message)
+
+	return conversationSID, conversationSID != ""
+}
@@ -0,0 +1,284 @@
+package twiligo_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+
+	twiligo "github.com/craigpaul/twiligo/pkg"
+)
+
+const createdConversationParticipantResponse = `{
+	"account_sid": "ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"conversation_sid": "CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"sid": "MBXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"identity": null,
+	"attributes": "{}",
+	"messaging_binding": {
+		"type": "sms",
+		"address": "+15555555555",
+		"proxy_address": "+16666666666"
+	},
+	"role_sid": "RLXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"date_created": "2020-07-30T00:00:00Z",
+	"date_updated": "2020-07-30T00:00:00Z",
+	"url": "https://conversations.twilio.com/v1/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Participants/MBXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"last_read_message_index": null,
+	"last_read_timestamp": null
+}`
+
+const duplicateParticipantBindingResponse = `{
+	"code": 50416,
+	"message": "A binding for this participant and proxy address already exists in Conversation CHYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY",
+	"more_info": "https://www.twilio.com/docs/errors/50416",
+	"status": 409
+}`
+
+const participantConversationsResponse = `{
+	"conversations": [
+		{
+			"account_sid": "ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"chat_service_sid": "ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"participant_sid": "MBXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"participant_user_sid": null,
+			"participant_identity": null,
+			"participant_messaging_binding": {
+				"type": "sms",
+				"address": "+15555555555",
+				"proxy_address": "+16666666666"
+			},
+			"conversation_sid": "CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"conversation_unique_name": null,
+			"conversation_friendly_name": "Friendly Conversation",
+			"conversation_attributes": "{}",
+			"conversation_date_created": "2020-07-30T00:00:00Z",
+			"conversation_date_updated": "2020-07-30T00:00:00Z",
+			"conversation_created_by": "system",
+			"conversation_state": "active",
+			"conversation_timers": {},
+			"links": {
+				"participant": "https://conversations.twilio.com/v1/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Participants/MBXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+				"conversation": "https://conversations.twilio.com/v1/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+			}
+		}
+	],
+	"meta": {
+		"page": 0,
+		"page_size": 50,
+		"first_page_url": "https://conversations.twilio.com/v1/ParticipantConversations?Address=%2B15555555555&PageSize=50&Page=0",
+		"previous_page_url": null,
+		"url": "https://conversations.twilio.com/v1/ParticipantConversations?Address=%2B15555555555&PageSize=50&Page=0",
+		"next_page_url": null,
+		"key": "conversations"
+	}
+}`
+
+func TestWillMakeRequestToCreateNewConversationParticipantSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Participants"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		if req.Header.Get("Authorization") == "" {
+			t.Log("Missing authorization credentials, they should be supplied via the Authorization header")
+			t.Fail()
+		}
+
+		if req.Header.Get("Content-Type") != "application/x-www-form-urlencoded" {
+			t.Logf("Incorrect content-type header supplied, expecting [%s], but received [%s]", "application/x-www-form-urlencoded", req.Header.Get("Content-Type"))
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(createdConversationParticipantResponse)),
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.CreateNewConversationParticipant("CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.ConversationParticipantOptions{})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if response.MessagingBinding == nil || response.MessagingBinding.ProxyAddress != "+16666666666" {
+		t.Logf("Incorrect messaging binding decoded, received [%v]", response.MessagingBinding)
+		t.Fail()
+	}
+}
+
+func TestWillIncludeProperRequestBodyParametersWhenMakingRequestToCreateNewConversationParticipantSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		if params.Get("MessagingBinding.Address") != "+15555555555" {
+			t.Logf("Incorrect request parameter supplied, expecting [%s], but received [%s]", "+15555555555", params.Get("MessagingBinding.Address"))
+			t.Fail()
+		}
+
+		if params.Get("MessagingBinding.ProxyAddress") != "+16666666666" {
+			t.Logf("Incorrect request parameter supplied, expecting [%s], but received [%s]", "+16666666666", params.Get("MessagingBinding.ProxyAddress"))
+			t.Fail()
+		}
+
+		if params.Get("RoleSid") != "RLXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX" {
+			t.Logf("Incorrect request parameter supplied, expecting [%s], but received [%s]", "RLXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", params.Get("RoleSid"))
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(createdConversationParticipantResponse)),
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+		}
+	})
+
+	twilio.CreateNewConversationParticipant("CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.ConversationParticipantOptions{
+		MessagingBindingAddress:      "+15555555555",
+		MessagingBindingProxyAddress: "+16666666666",
+		RoleSID:                      "RLXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	})
+}
+
+func TestWillHandleDuplicateBindingErrorResponsesWhenMakingRequestToCreateNewConversationParticipant(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(duplicateParticipantBindingResponse)),
+			StatusCode: http.StatusConflict,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.CreateNewConversationParticipant("CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.ConversationParticipantOptions{})
+
+	if response != nil {
+		t.Logf("Response was incorrectly returned, was not expecting the following response: %v", response)
+		t.Fail()
+	}
+
+	conversationSID, ok := twiligo.ExistingConversationSID(err)
+
+	if ok == false || conversationSID != "CHYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY" {
+		t.Logf("Incorrect existing conversation returned, expected [%s], but received [%s]", "CHYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY", conversationSID)
+		t.Fail()
+	}
+}
+
+func TestWillNotExtractExistingConversationFromUnrelatedErrors(t *testing.T) {
+	cases := []error{
+		nil,
+		errors.New("A binding for this participant and proxy address already exists in Conversation CHYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY"),
+		&twiligo.Exception{Code: 50304, Message: "Attributes not valid JSON"},
+		&twiligo.Exception{Code: twiligo.DuplicateParticipantBindingErrorCode, Message: "A binding for this participant and proxy address already exists"},
+	}
+
+	for _, given := range cases {
+		if conversationSID, ok := twiligo.ExistingConversationSID(given); ok {
+			t.Logf("Existing conversation was incorrectly returned, was not expecting [%s] from [%v]", conversationSID, given)
+			t.Fail()
+		}
+	}
+}
+
+func TestWillMakeRequestToListConversationParticipantsSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Participants"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"participants": [` + createdConversationParticipantResponse + `], "meta": {"page": 0, "page_size": 50}}`)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.ListConversationParticipants("CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.PageOptions{})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if len(response.Participants) != 1 {
+		t.Logf("Incorrect number of participants returned, expecting [%d], but received [%d]", 1, len(response.Participants))
+		t.Fail()
+	}
+}
+
+func TestCanDeleteExistingConversationParticipantSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Participants/MBXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(``)),
+			StatusCode: http.StatusNoContent,
+			Header:     make(http.Header),
+		}
+	})
+
+	err := twilio.DeleteConversationParticipant("CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "MBXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX")
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToListParticipantConversationsByAddressSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/ParticipantConversations"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		if req.URL.Query().Get("Address") != "+15555555555" {
+			t.Logf("Incorrect query parameter supplied, expecting [%s], but received [%s]", "+15555555555", req.URL.Query().Get("Address"))
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(participantConversationsResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	}).WithConversationService("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX")
+
+	response, err := twilio.ListParticipantConversations(twiligo.ListParticipantConversationsOptions{
+		Address: "+15555555555",
+	})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if len(response.Conversations) != 1 {
+		t.Logf("Incorrect number of conversations returned, expecting [%d], but received [%d]", 1, len(response.Conversations))
+		t.FailNow()
+	}
+
+	if response.Conversations[0].ConversationState != twiligo.ActiveConversation {
+		t.Logf("Incorrect conversation state decoded, expecting [%s], but received [%s]", twiligo.ActiveConversation, response.Conversations[0].ConversationState)
+		t.Fail()
+	}
+}
@@ -0,0 +1,181 @@
+package twiligo
+
+import (
+	"net/http"
+	"time"
+)
+
+// ConversationAddEvent represents the onConversationAdd pre-event webhook sent from Twilio before a conversation is created.
+type ConversationAddEvent struct {
+	ConversationWebhookEvent
+	MessagingServiceSID          string    `json:"MessagingServiceSid"`
+	UniqueName                   string    `json:"UniqueName"`
+	FriendlyName                 string    `json:"FriendlyName"`
+	Attributes                   string    `json:"Attributes"`
+	MessagingBindingAddress      string    `json:"MessagingBinding.Address"`
+	MessagingBindingProxyAddress string    `json:"MessagingBinding.ProxyAddress"`
+	DateCreated                  time.Time `json:"DateCreated"`
+}
+
+// ConversationUpdateEvent represents the onConversationUpdate pre-event webhook sent from Twilio before a conversation is updated.
+type ConversationUpdateEvent struct {
+	ConversationWebhookEvent
+	ConversationSID     string            `json:"ConversationSid"`
+	MessagingServiceSID string            `json:"MessagingServiceSid"`
+	UniqueName          string            `json:"UniqueName"`
+	FriendlyName        string            `json:"FriendlyName"`
+	Attributes          string            `json:"Attributes"`
+	State               ConversationState `json:"State"`
+	DateCreated         time.Time         `json:"DateCreated"`
+	DateUpdated         time.Time         `json:"DateUpdated"`
+}
+
+// ConversationRemoveEvent represents the onConversationRemove pre-event webhook sent from Twilio before a conversation is deleted.
+type ConversationRemoveEvent struct {
+	ConversationWebhookEvent
+	ConversationSID     string            `json:"ConversationSid"`
+	MessagingServiceSID string            `json:"MessagingServiceSid"`
+	UniqueName          string            `json:"UniqueName"`
+	FriendlyName        string            `json:"FriendlyName"`
+	Attributes          string            `json:"Attributes"`
+	State               ConversationState `json:"State"`
+	DateCreated         time.Time         `json:"DateCreated"`
+	DateUpdated         time.Time         `json:"DateUpdated"`
+}
+
+// ConversationMessageAddEvent represents the onMessageAdd pre-event webhook sent from Twilio before a message is added to a conversation.
+type ConversationMessageAddEvent struct {
+	ConversationWebhookEvent
+	ConversationSID     string                      `json:"ConversationSid"`
+	MessagingServiceSID string                      `json:"MessagingServiceSid"`
+	Author              string                      `json:"Author"`
+	Body                string                      `json:"Body"`
+	Media               []*ConversationWebhookMedia `json:"Media"`
+	Attributes          string                      `json:"Attributes"`
+	ParticipantSID      string                      `json:"ParticipantSid"`
+}
+
+// ConversationMessageUpdateEvent represents the onMessageUpdate pre-event webhook sent from Twilio before a message within a conversation is updated.
+type ConversationMessageUpdateEvent struct {
+	ConversationWebhookEvent
+	ConversationSID     string                      `json:"ConversationSid"`
+	MessagingServiceSID string                      `json:"MessagingServiceSid"`
+	MessageSID          string                      `json:"MessageSid"`
+	Index               int                         `json:"Index"`
+	Author              string                      `json:"Author"`
+	Body                string                      `json:"Body"`
+	Media               []*ConversationWebhookMedia `json:"Media"`
+	Attributes          string                      `json:"Attributes"`
+	ParticipantSID      string                      `json:"ParticipantSid"`
+	DateCreated         time.Time                   `json:"DateCreated"`
+	DateUpdated         time.Time                   `json:"DateUpdated"`
+}
+
+// ConversationMessageRemoveEvent represents the onMessageRemove pre-event webhook sent from Twilio before a message is deleted from a conversation.
+type ConversationMessageRemoveEvent struct {
+	ConversationWebhookEvent
+	ConversationSID     string                      `json:"ConversationSid"`
+	MessagingServiceSID string                      `json:"MessagingServiceSid"`
+	MessageSID          string                      `json:"MessageSid"`
+	Index               int                         `json:"Index"`
+	Author              string                      `json:"Author"`
+	Body                string                      `json:"Body"`
+	Media               []*ConversationWebhookMedia `json:"Media"`
+	Attributes          string                      `json:"Attributes"`
+	ParticipantSID      string                      `json:"ParticipantSid"`
+	DateCreated         time.Time                   `json:"DateCreated"`
+	DateUpdated         time.Time                   `json:"DateUpdated"`
+}
+
+// ConversationParticipantAddEvent represents the onParticipantAdd pre-event webhook sent from Twilio before a participant is added to a conversation.
+type ConversationParticipantAddEvent struct {
+	ConversationWebhookEvent
+	ConversationSID                  string `json:"ConversationSid"`
+	MessagingServiceSID              string `json:"MessagingServiceSid"`
+	Identity                         string `json:"Identity"`
+	RoleSID                          string `json:"RoleSid"`
+	Attributes                       string `json:"Attributes"`
+	MessagingBindingType             string `json:"MessagingBinding.Type"`
+	MessagingBindingAddress          string `json:"MessagingBinding.Address"`
+	MessagingBindingProxyAddress     string `json:"MessagingBinding.ProxyAddress"`
+	MessagingBindingProjectedAddress string `json:"MessagingBinding.ProjectedAddress"`
+}
+
+// ConversationParticipantUpdateEvent represents the onParticipantUpdate pre-event webhook sent from Twilio before a participant within a conversation is updated.
+type ConversationParticipantUpdateEvent struct {
+	ConversationWebhookEvent
+	ConversationSID                  string    `json:"ConversationSid"`
+	MessagingServiceSID              string    `json:"MessagingServiceSid"`
+	ParticipantSID                   string    `json:"ParticipantSid"`
+	Identity                         string    `json:"Identity"`
+	RoleSID                          string    `json:"RoleSid"`
+	Attributes                       string    `json:"Attributes"`
+	MessagingBindingType             string    `json:"MessagingBinding.Type"`
+	MessagingBindingAddress          string    `json:"MessagingBinding.Address"`
+	MessagingBindingProxyAddress     string    `json:"MessagingBinding.ProxyAddress"`
+	MessagingBindingProjectedAddress string    `json:"MessagingBinding.ProjectedAddress"`
+	DateCreated                      time.Time `json:"DateCreated"`
+	DateUpdated                      time.Time `json:"DateUpdated"`
+}
+
+// ConversationParticipantRemoveEvent represents the onParticipantRemove pre-event webhook sent from Twilio before a participant is removed from a conversation.
+type ConversationParticipantRemoveEvent struct {
+	ConversationWebhookEvent
+	ConversationSID                  string    `json:"ConversationSid"`
+	MessagingServiceSID              string    `json:"MessagingServiceSid"`
+	ParticipantSID                   string    `json:"ParticipantSid"`
+	Identity                         string    `json:"Identity"`
+	RoleSID                          string    `json:"RoleSid"`
+	Attributes                       string    `json:"Attributes"`
+	MessagingBindingType             string    `json:"MessagingBinding.Type"`
+	MessagingBindingAddress          string    `json:"MessagingBinding.Address"`
+	MessagingBindingProxyAddress     string    `json:"MessagingBinding.ProxyAddress"`
+	MessagingBindingProjectedAddress string    `json:"MessagingBinding.ProjectedAddress"`
+	DateCreated                      time.Time `json:"DateCreated"`
+	DateUpdated                      time.Time `json:"DateUpdated"`
+}
+
+// ConversationActionModification holds the values that should replace those of the pending action when responding to a pre-event webhook. Any field left nil is kept as it was sent.
+type ConversationActionModification struct {
+	Body       *string `json:"body,omitempty"`
+	Author     *string `json:"author,omitempty"`
+	Attributes *string `json:"attributes,omitempty"`
+}
+
+// ConversationPreEventHandlerFunc handles a single Conversations pre-event webhook, deciding whether the action should be accepted, rejected or modified via the given response writer.
+type ConversationPreEventHandlerFunc func(event ConversationEvent, w *ConversationPreEventResponseWriter)
+
+// ConversationPreEventResponseWriter writes the response to a Conversations pre-event webhook, telling Twilio whether the pending action should go ahead. Only the first call to Accept, Reject or Modify takes effect.
+type ConversationPreEventResponseWriter struct {
+	*preEventResponseWriter
+}
+
+// NewConversationPreEventHandler creates an http.Handler for Conversations pre-event webhooks. Requests are verified with CheckSignature against the given base URL before being parsed and passed along to the given function, and actions are accepted unless the function rejects or modifies them.
+func (twilio *Twilio) NewConversationPreEventHandler(baseURL string, handle ConversationPreEventHandlerFunc) http.Handler {
+	return twilio.newSignedWebhookHandler(baseURL, func(r *http.Request, response *preEventResponseWriter) error {
+		event, err := ParseConversationWebhook(r)
+
+		if err != nil {
+			return err
+		}
+
+		handle(event, &ConversationPreEventResponseWriter{response})
+
+		return nil
+	})
+}
+
+// Accept lets the pending action go ahead unchanged.
+func (response *ConversationPreEventResponseWriter) Accept() {
+	response.writeStatus(http.StatusOK)
+}
+
+// Reject stops the pending action from taking place.
+func (response *ConversationPreEventResponseWriter) Reject() {
+	response.writeStatus(http.StatusForbidden)
+}
+
+// Modify lets the pending action go ahead using the values of the given modification in place of those that were sent.
+func (response *ConversationPreEventResponseWriter) Modify(modification ConversationActionModification) error {
+	return response.writeJSON(modification)
+}
@@ -0,0 +1,122 @@
+package twiligo_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	twiligo "github.com/craigpaul/twiligo/pkg"
+)
+
+func NewSignedTestWebhookRequest(twilio *twiligo.Twilio, values url.Values) *http.Request {
+	req := NewTestWebhookRequest(values)
+
+	signature, _ := twilio.GenerateSignature("https://example.com"+req.URL.String(), values)
+
+	req.Header.Set("X-Twilio-Signature", string(signature))
+
+	return req
+}
+
+func TestWillAcceptConversationPreEventWhenHandlerDoesNotRespond(t *testing.T) {
+	twilio := twiligo.New("123", "456")
+
+	handler := twilio.NewConversationPreEventHandler("https://example.com", func(event twiligo.ConversationEvent, w *twiligo.ConversationPreEventResponseWriter) {
+		if _, ok := event.(*twiligo.ConversationMessageAddEvent); ok == false {
+			t.Logf("Incorrect event received, expected [%T], but received [%T]", &twiligo.ConversationMessageAddEvent{}, event)
+			t.Fail()
+		}
+	})
+
+	recorder := httptest.NewRecorder()
+
+	handler.ServeHTTP(recorder, NewSignedTestWebhookRequest(twilio, url.Values{
+		"EventType": {"onMessageAdd"},
+		"Body":      {"Hello"},
+	}))
+
+	if recorder.Code != http.StatusOK || recorder.Body.Len() != 0 {
+		t.Logf("Incorrect response written, expected an empty [%d], but received [%d] %s", http.StatusOK, recorder.Code, recorder.Body)
+		t.Fail()
+	}
+}
+
+func TestWillRejectConversationPreEventWhenHandlerRejectsAction(t *testing.T) {
+	twilio := twiligo.New("123", "456")
+
+	handler := twilio.NewConversationPreEventHandler("https://example.com", func(event twiligo.ConversationEvent, w *twiligo.ConversationPreEventResponseWriter) {
+		w.Reject()
+		w.Accept()
+	})
+
+	recorder := httptest.NewRecorder()
+
+	handler.ServeHTTP(recorder, NewSignedTestWebhookRequest(twilio, url.Values{
+		"EventType": {"onParticipantAdd"},
+		"Identity":  {"troll"},
+	}))
+
+	if recorder.Code != http.StatusForbidden {
+		t.Logf("Incorrect status code written, expected [%d], but received [%d]", http.StatusForbidden, recorder.Code)
+		t.Fail()
+	}
+}
+
+func TestWillModifyConversationPreEventWhenHandlerModifiesAction(t *testing.T) {
+	twilio := twiligo.New("123", "456")
+
+	handler := twilio.NewConversationPreEventHandler("https://example.com", func(event twiligo.ConversationEvent, w *twiligo.ConversationPreEventResponseWriter) {
+		message := event.(*twiligo.ConversationMessageAddEvent)
+
+		body := "H*llo"
+
+		if message.Body == "Hello" {
+			w.Modify(twiligo.ConversationActionModification{Body: &body})
+		}
+	})
+
+	recorder := httptest.NewRecorder()
+
+	handler.ServeHTTP(recorder, NewSignedTestWebhookRequest(twilio, url.Values{
+		"EventType": {"onMessageAdd"},
+		"Body":      {"Hello"},
+	}))
+
+	response := map[string]string{}
+
+	json.Unmarshal(recorder.Body.Bytes(), &response)
+
+	if recorder.Code != http.StatusOK || len(response) != 1 || response["body"] != "H*llo" {
+		t.Logf("Incorrect response written, received [%d] %s", recorder.Code, recorder.Body)
+		t.Fail()
+	}
+
+	if recorder.Header().Get("Content-Type") != "application/json" {
+		t.Logf("Incorrect content-type header written, expecting [%s], but received [%s]", "application/json", recorder.Header().Get("Content-Type"))
+		t.Fail()
+	}
+}
+
+func TestWillNotHandleConversationPreEventWithInvalidSignature(t *testing.T) {
+	twilio := twiligo.New("123", "456")
+
+	handler := twilio.NewConversationPreEventHandler("https://example.com", func(event twiligo.ConversationEvent, w *twiligo.ConversationPreEventResponseWriter) {
+		t.Logf("Handler was incorrectly called, was not expecting the following event: %v", event)
+		t.Fail()
+	})
+
+	req := NewTestWebhookRequest(url.Values{"EventType": {"onMessageAdd"}})
+
+	req.Header.Set("X-Twilio-Signature", "invalid")
+
+	recorder := httptest.NewRecorder()
+
+	handler.ServeHTTP(recorder, req)
+
+	if recorder.Code != http.StatusForbidden {
+		t.Logf("Incorrect status code written, expected [%d], but received [%d]", http.StatusForbidden, recorder.Code)
+		t.Fail()
+	}
+}
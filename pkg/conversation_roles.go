@@ -0,0 +1,325 @@
+package twiligo
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+// This constant is used to represent whether a ConversationRole applies to a single Conversation or to the entire Conversation Service.
+const (
+	ConversationScopedRole ConversationRoleType = iota + 1
+	ConversationServiceScopedRole
+)
+
+// This constant is used to represent the permissions that can be granted by a ConversationServiceScopedRole.
+const (
+	ConversationCreateConversationPermission ConversationPermission = iota + 1
+	ConversationJoinConversationPermission
+	ConversationEditOwnUserInfoPermission
+	ConversationEditAnyUserInfoPermission
+)
+
+// This constant is used to represent the permissions that can be granted by a ConversationScopedRole.
+const (
+	ConversationAddParticipantPermission ConversationPermission = iota + 100
+	ConversationRemoveParticipantPermission
+	ConversationLeaveConversationPermission
+	ConversationDeleteConversationPermission
+	ConversationEditConversationNamePermission
+	ConversationEditConversationAttributesPermission
+	ConversationEditNotificationLevelPermission
+	ConversationSendMessagePermission
+	ConversationSendMediaMessagePermission
+	ConversationEditOwnMessagePermission
+	ConversationEditAnyMessagePermission
+	ConversationEditOwnMessageAttributesPermission
+	ConversationEditAnyMessageAttributesPermission
+	ConversationDeleteOwnMessagePermission
+	ConversationDeleteAnyMessagePermission
+	ConversationEditOwnParticipantAttributesPermission
+	ConversationEditAnyParticipantAttributesPermission
+)
+
+// ConversationPermission is used to define a single action that a ConversationRole allows a user or participant to take.
+type ConversationPermission int
+
+// ConversationRole represents a named set of permissions that can be assigned to users or participants within a Conversation Service.
+type ConversationRole struct {
+	SID            string                   `json:"sid"`
+	AccountSID     string                   `json:"account_sid"`
+	ChatServiceSID string                   `json:"chat_service_sid"`
+	FriendlyName   string                   `json:"friendly_name"`
+	Type           ConversationRoleType     `json:"type"`
+	Permissions    []ConversationPermission `json:"permissions"`
+	DateCreated    time.Time                `json:"date_created"`
+	DateUpdated    time.Time                `json:"date_updated"`
+	URL            string                   `json:"url"`
+}
+
+// ConversationRolesResponse is the representation of the JSON response from Twilio when listing conversation roles.
+type ConversationRolesResponse struct {
+	Roles []*ConversationRole `json:"roles"`
+	Meta  Meta                `json:"meta"`
+}
+
+// ConversationRoleType is used to define whether a ConversationRole applies to a single Conversation or to the entire Conversation Service.
+type ConversationRoleType int
+
+type conversationRoleOptions struct {
+	FriendlyName string                   `url:",omitempty"`
+	Type         ConversationRoleType     `url:",omitempty"`
+	Permission   []ConversationPermission `url:",omitempty"`
+}
+
+// CreateNewConversationRole creates a new role in Twilio that grants the given permissions. All of the given permissions must belong to the same scope as the given role type.
+func (twilio *Twilio) CreateNewConversationRole(name string, roleType ConversationRoleType, permissions []ConversationPermission) (*ConversationRole, error) {
+	if len(permissions) == 0 {
+		return nil, errors.New("Missing required parameter Permission")
+	}
+
+	for _, permission := range permissions {
+		if permission.Scope() != roleType {
+			return nil, errors.New("Permission " + permission.String() + " cannot be granted by a " + roleType.String() + " role")
+		}
+	}
+
+	params, err := query.Values(conversationRoleOptions{
+		FriendlyName: name,
+		Type:         roleType,
+		Permission:   permissions,
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.scopedConversationURL("Roles"), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusCreated {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ConversationRole)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// FetchConversationRole retrieves the role matching the given identifier from Twilio.
+func (twilio *Twilio) FetchConversationRole(roleSID string) (*ConversationRole, error) {
+	res, err := twilio.get(twilio.scopedConversationURL("Roles/"+roleSID), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ConversationRole)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// ListConversationRoles retrieves a single page of roles from Twilio.
+func (twilio *Twilio) ListConversationRoles(options PageOptions) (*ConversationRolesResponse, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.get(twilio.scopedConversationURL("Roles"), &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ConversationRolesResponse)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// UpdateConversationRole will replace the permissions of an existing role in Twilio with the given permissions.
+func (twilio *Twilio) UpdateConversationRole(roleSID string, permissions []ConversationPermission) (*ConversationRole, error) {
+	if len(permissions) == 0 {
+		return nil, errors.New("Missing required parameter Permission")
+	}
+
+	params, err := query.Values(conversationRoleOptions{Permission: permissions})
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.scopedConversationURL("Roles/"+roleSID), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ConversationRole)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// DeleteConversationRole will completely remove the role matching the given identifier from within Twilio.
+func (twilio *Twilio) DeleteConversationRole(roleSID string) error {
+	res, err := twilio.delete(twilio.scopedConversationURL("Roles/" + roleSID))
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		decoder := json.NewDecoder(res.Body)
+
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return err
+	}
+
+	return nil
+}
+
+// Scope returns the type of role that is able to grant the current permission.
+func (permission ConversationPermission) Scope() ConversationRoleType {
+	if permission >= ConversationAddParticipantPermission {
+		return ConversationScopedRole
+	}
+
+	return ConversationServiceScopedRole
+}
+
+// MarshalJSON handles converting a ConversationPermission into the string representation used by Twilio.
+func (permission ConversationPermission) MarshalJSON() ([]byte, error) {
+	return json.Marshal(permission.String())
+}
+
+// UnmarshalJSON handles converting the string representation used by Twilio into a ConversationPermission.
+func (permission *ConversationPermission) UnmarshalJSON(b []byte) error {
+	var s string
+
+	err := json.Unmarshal(b, &s)
+
+	if err != nil {
+		return err
+	}
+
+	*permission = ConvertPermissionToConversationPermission(s)
+
+	return nil
+}
+
+func (permission ConversationPermission) String() string {
+	return map[ConversationPermission]string{
+		ConversationCreateConversationPermission:           "createConversation",
+		ConversationJoinConversationPermission:             "joinConversation",
+		ConversationEditOwnUserInfoPermission:              "editOwnUserInfo",
+		ConversationEditAnyUserInfoPermission:              "editAnyUserInfo",
+		ConversationAddParticipantPermission:               "addParticipant",
+		ConversationRemoveParticipantPermission:            "removeParticipant",
+		ConversationLeaveConversationPermission:            "leaveConversation",
+		ConversationDeleteConversationPermission:           "deleteConversation",
+		ConversationEditConversationNamePermission:         "editConversationName",
+		ConversationEditConversationAttributesPermission:   "editConversationAttributes",
+		ConversationEditNotificationLevelPermission:        "editNotificationLevel",
+		ConversationSendMessagePermission:                  "sendMessage",
+		ConversationSendMediaMessagePermission:             "sendMediaMessage",
+		ConversationEditOwnMessagePermission:               "editOwnMessage",
+		ConversationEditAnyMessagePermission:               "editAnyMessage",
+		ConversationEditOwnMessageAttributesPermission:     "editOwnMessageAttributes",
+		ConversationEditAnyMessageAttributesPermission:     "editAnyMessageAttributes",
+		ConversationDeleteOwnMessagePermission:             "deleteOwnMessage",
+		ConversationDeleteAnyMessagePermission:             "deleteAnyMessage",
+		ConversationEditOwnParticipantAttributesPermission: "editOwnParticipantAttributes",
+		ConversationEditAnyParticipantAttributesPermission: "editAnyParticipantAttributes",
+	}[permission]
+}
+
+// MarshalJSON handles converting a ConversationRoleType into the string representation used by Twilio.
+func (roleType ConversationRoleType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(roleType.String())
+}
+
+// UnmarshalJSON handles converting the string representation used by Twilio into a ConversationRoleType.
+func (roleType *ConversationRoleType) UnmarshalJSON(b []byte) error {
+	var s string
+
+	err := json.Unmarshal(b, &s)
+
+	if err != nil {
+		return err
+	}
+
+	*roleType = ConvertTypeToConversationRoleType(s)
+
+	return nil
+}
+
+func (roleType ConversationRoleType) String() string {
+	return map[ConversationRoleType]string{
+		ConversationScopedRole:        "conversation",
+		ConversationServiceScopedRole: "service",
+	}[roleType]
+}
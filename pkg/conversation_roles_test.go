@@ -0,0 +1,261 @@
+package twiligo_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+
+	twiligo "github.com/craigpaul/twiligo/pkg"
+)
+
+const conversationRoleResponse = `{
+	"sid": "RLXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"account_sid": "ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"chat_service_sid": "ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"friendly_name": "observer",
+	"type": "conversation",
+	"permissions": ["leaveConversation", "editNotificationLevel"],
+	"date_created": "2020-07-30T00:00:00Z",
+	"date_updated": "2020-07-30T00:00:00Z",
+	"url": "https://conversations.twilio.com/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Roles/RLXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}`
+
+const roleNameAlreadyExistsResponse = `{
+	"code": 50353,
+	"message": "Role with the provided friendly name already exists",
+	"more_info": "https://www.twilio.com/docs/errors/50353",
+	"status": 409
+}`
+
+func TestWillMakeRequestToCreateNewConversationRoleSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Roles"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		if req.Header.Get("Authorization") == "" {
+			t.Log("Missing authorization credentials, they should be supplied via the Authorization header")
+			t.Fail()
+		}
+
+		if req.Header.Get("Content-Type") != "application/x-www-form-urlencoded" {
+			t.Logf("Incorrect content-type header supplied, expecting [%s], but received [%s]", "application/x-www-form-urlencoded", req.Header.Get("Content-Type"))
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(conversationRoleResponse)),
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+		}
+	}).WithConversationService("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX")
+
+	response, err := twilio.CreateNewConversationRole("observer", twiligo.ConversationScopedRole, []twiligo.ConversationPermission{
+		twiligo.ConversationLeaveConversationPermission,
+		twiligo.ConversationEditNotificationLevelPermission,
+	})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if response.Type != twiligo.ConversationScopedRole {
+		t.Logf("Incorrect role type decoded, expecting [%s], but received [%s]", twiligo.ConversationScopedRole, response.Type)
+		t.Fail()
+	}
+
+	if len(response.Permissions) != 2 || response.Permissions[0] != twiligo.ConversationLeaveConversationPermission {
+		t.Logf("Incorrect permissions decoded, received [%v]", response.Permissions)
+		t.Fail()
+	}
+}
+
+func TestWillIncludeProperRequestBodyParametersWhenMakingRequestToCreateNewConversationRoleSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		if params.Get("FriendlyName") != "observer" {
+			t.Logf("Incorrect request parameter supplied, expecting [%s], but received [%s]", "observer", params.Get("FriendlyName"))
+			t.Fail()
+		}
+
+		if params.Get("Type") != "conversation" {
+			t.Logf("Incorrect request parameter supplied, expecting [%s], but received [%s]", "conversation", params.Get("Type"))
+			t.Fail()
+		}
+
+		permissions := params["Permission"]
+
+		if len(permissions) != 2 || permissions[0] != "leaveConversation" || permissions[1] != "editNotificationLevel" {
+			t.Logf("Incorrect permissions supplied, received [%v]", permissions)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(conversationRoleResponse)),
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+		}
+	})
+
+	twilio.CreateNewConversationRole("observer", twiligo.ConversationScopedRole, []twiligo.ConversationPermission{
+		twiligo.ConversationLeaveConversationPermission,
+		twiligo.ConversationEditNotificationLevelPermission,
+	})
+}
+
+func TestWillNotMakeRequestIfPermissionsDoNotMatchTheRoleTypeToCreateNewConversationRole(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		t.Logf("Request was incorrectly made, was not expecting the following request: %v", req)
+		t.FailNow()
+
+		return &http.Response{}
+	})
+
+	response, err := twilio.CreateNewConversationRole("observer", twiligo.ConversationServiceScopedRole, []twiligo.ConversationPermission{
+		twiligo.ConversationJoinConversationPermission,
+		twiligo.ConversationSendMessagePermission,
+	})
+
+	if response != nil {
+		t.Logf("Response was incorrectly returned, was not expecting the following response: %v", response)
+		t.Fail()
+	}
+
+	expected := "Permission sendMessage cannot be granted by a service role"
+
+	if err == nil || err.Error() != expected {
+		t.Logf("Incorrect error returned, expected [%s], but received [%v]", expected, err)
+		t.Fail()
+	}
+}
+
+func TestWillHandleErrorResponsesWhenMakingRequestToCreateNewConversationRole(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(roleNameAlreadyExistsResponse)),
+			StatusCode: http.StatusConflict,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.CreateNewConversationRole("observer", twiligo.ConversationServiceScopedRole, []twiligo.ConversationPermission{
+		twiligo.ConversationJoinConversationPermission,
+	})
+
+	if response != nil {
+		t.Logf("Response was incorrectly returned, was not expecting the following response: %v", response)
+		t.Fail()
+	}
+
+	expected := "Role with the provided friendly name already exists"
+
+	if err.Error() != expected {
+		t.Logf("Incorrect error returned, expected [%s], but received [%s]", expected, err)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToListConversationRolesSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Roles"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"roles": [` + conversationRoleResponse + `], "meta": {"page": 0, "page_size": 50}}`)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.ListConversationRoles(twiligo.PageOptions{})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if len(response.Roles) != 1 {
+		t.Logf("Incorrect number of roles returned, expecting [%d], but received [%d]", 1, len(response.Roles))
+		t.Fail()
+	}
+}
+
+func TestWillIncludeProperRequestBodyParametersWhenMakingRequestToUpdateConversationRoleSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Roles/RLXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		if params.Get("Permission") != "sendMessage" {
+			t.Logf("Incorrect request parameter supplied, expecting [%s], but received [%s]", "sendMessage", params.Get("Permission"))
+			t.Fail()
+		}
+
+		if _, ok := params["Type"]; ok {
+			t.Log("Unexpected request parameter supplied for [Type]")
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(conversationRoleResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.UpdateConversationRole("RLXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", []twiligo.ConversationPermission{
+		twiligo.ConversationSendMessagePermission,
+	})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+
+	if response == nil {
+		t.Log("Did not receive the expected response")
+		t.Fail()
+	}
+}
+
+func TestCanDeleteExistingConversationRoleSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Roles/RLXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(``)),
+			StatusCode: http.StatusNoContent,
+			Header:     make(http.Header),
+		}
+	})
+
+	err := twilio.DeleteConversationRole("RLXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX")
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+}
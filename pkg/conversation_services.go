@@ -0,0 +1,447 @@
+package twiligo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+// ConversationNotificationOptions are all of the options that can be provided for a single type of push notification sent by a Conversation Service.
+type ConversationNotificationOptions struct {
+	Enabled  *bool  `url:",omitempty"`
+	Template string `url:",omitempty"`
+	Sound    string `url:",omitempty"`
+}
+
+// ConversationNewMessageNotificationOptions are all of the options that can be provided for the push notification sent by a Conversation Service when a new message is added.
+type ConversationNewMessageNotificationOptions struct {
+	Enabled           *bool                                `url:",omitempty"`
+	Template          string                               `url:",omitempty"`
+	Sound             string                               `url:",omitempty"`
+	BadgeCountEnabled *bool                                `url:",omitempty"`
+	WithMedia         ConversationMediaNotificationOptions `url:",omitempty"`
+}
+
+// ConversationMediaNotificationOptions are all of the options that can be provided for the push notification sent by a Conversation Service when a new message containing media is added.
+type ConversationMediaNotificationOptions struct {
+	Enabled  *bool  `url:",omitempty"`
+	Template string `url:",omitempty"`
+}
+
+// ConversationService represents a Twilio Conversation Service that owns its own isolated set of conversations, users, roles, etc.
+type ConversationService struct {
+	SID          string    `json:"sid"`
+	AccountSID   string    `json:"account_sid"`
+	FriendlyName string    `json:"friendly_name"`
+	DateCreated  time.Time `json:"date_created"`
+	DateUpdated  time.Time `json:"date_updated"`
+	URL          string    `json:"url"`
+	Links        struct {
+		Conversations            string `json:"conversations"`
+		Users                    string `json:"users"`
+		Roles                    string `json:"roles"`
+		Bindings                 string `json:"bindings"`
+		Configuration            string `json:"configuration"`
+		ParticipantConversations string `json:"participant_conversations"`
+	} `json:"links"`
+}
+
+// ConversationServiceConfiguration represents the default roles and reachability settings of a Twilio Conversation Service.
+type ConversationServiceConfiguration struct {
+	ChatServiceSID                    string `json:"chat_service_sid"`
+	DefaultConversationCreatorRoleSID string `json:"default_conversation_creator_role_sid"`
+	DefaultConversationRoleSID        string `json:"default_conversation_role_sid"`
+	DefaultChatServiceRoleSID         string `json:"default_chat_service_role_sid"`
+	ReachabilityEnabled               bool   `json:"reachability_enabled"`
+	URL                               string `json:"url"`
+	Links                             struct {
+		Notifications string `json:"notifications"`
+		Webhooks      string `json:"webhooks"`
+	} `json:"links"`
+}
+
+// ConversationServiceNotifications represents the push notification settings of a Twilio Conversation Service.
+type ConversationServiceNotifications struct {
+	AccountSID     string `json:"account_sid"`
+	ChatServiceSID string `json:"chat_service_sid"`
+	LogEnabled     bool   `json:"log_enabled"`
+	NewMessage     struct {
+		Enabled           bool    `json:"enabled"`
+		Template          *string `json:"template"`
+		Sound             *string `json:"sound"`
+		BadgeCountEnabled bool    `json:"badge_count_enabled"`
+		WithMedia         struct {
+			Enabled  bool    `json:"enabled"`
+			Template *string `json:"template"`
+		} `json:"with_media"`
+	} `json:"new_message"`
+	AddedToConversation     ConversationNotification `json:"added_to_conversation"`
+	RemovedFromConversation ConversationNotification `json:"removed_from_conversation"`
+	URL                     string                   `json:"url"`
+}
+
+// ConversationNotification represents the settings for a single type of push notification sent by a Twilio Conversation Service.
+type ConversationNotification struct {
+	Enabled  bool    `json:"enabled"`
+	Template *string `json:"template"`
+	Sound    *string `json:"sound"`
+}
+
+// ConversationServicesResponse is the representation of the JSON response from Twilio when listing conversation services.
+type ConversationServicesResponse struct {
+	Services []*ConversationService `json:"services"`
+	Meta     Meta                   `json:"meta"`
+}
+
+// ConversationServiceWebhooks represents the pre and post event webhook settings of a Twilio Conversation Service.
+type ConversationServiceWebhooks struct {
+	AccountSID     string   `json:"account_sid"`
+	ChatServiceSID string   `json:"chat_service_sid"`
+	PreWebhookURL  *string  `json:"pre_webhook_url"`
+	PostWebhookURL *string  `json:"post_webhook_url"`
+	Filters        []string `json:"filters"`
+	Method         string   `json:"method"`
+	URL            string   `json:"url"`
+}
+
+// UpdateConversationServiceConfigurationOptions are all of the options that can be provided to an UpdateConversationServiceConfiguration call.
+type UpdateConversationServiceConfigurationOptions struct {
+	DefaultConversationCreatorRoleSID string `url:"DefaultConversationCreatorRoleSid,omitempty"`
+	DefaultConversationRoleSID        string `url:"DefaultConversationRoleSid,omitempty"`
+	DefaultChatServiceRoleSID         string `url:"DefaultChatServiceRoleSid,omitempty"`
+	ReachabilityEnabled               *bool  `url:",omitempty"`
+}
+
+// UpdateConversationServiceNotificationsOptions are all of the options that can be provided to an UpdateConversationServiceNotifications call.
+type UpdateConversationServiceNotificationsOptions struct {
+	LogEnabled              *bool                                     `url:",omitempty"`
+	NewMessage              ConversationNewMessageNotificationOptions `url:",omitempty"`
+	AddedToConversation     ConversationNotificationOptions           `url:",omitempty"`
+	RemovedFromConversation ConversationNotificationOptions           `url:",omitempty"`
+}
+
+// UpdateConversationServiceWebhooksOptions are all of the options that can be provided to an UpdateConversationServiceWebhooks call.
+type UpdateConversationServiceWebhooksOptions struct {
+	PreWebhookURL  string   `url:"PreWebhookUrl,omitempty"`
+	PostWebhookURL string   `url:"PostWebhookUrl,omitempty"`
+	Filters        []string `url:",omitempty"`
+	Method         string   `url:",omitempty"`
+}
+
+type createNewConversationServiceOptions struct {
+	FriendlyName string
+}
+
+// CreateNewConversationService creates a new conversation service in Twilio.
+func (twilio *Twilio) CreateNewConversationService(name string) (*ConversationService, error) {
+	params, err := query.Values(createNewConversationServiceOptions{FriendlyName: name})
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.conversationURL("Services"), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusCreated {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ConversationService)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// FetchConversationService retrieves the conversation service matching the given identifier from Twilio.
+func (twilio *Twilio) FetchConversationService(serviceSID string) (*ConversationService, error) {
+	res, err := twilio.get(twilio.conversationURL("Services/"+serviceSID), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ConversationService)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// ListConversationServices retrieves a single page of the conversation services belonging to the account from Twilio.
+func (twilio *Twilio) ListConversationServices(options PageOptions) (*ConversationServicesResponse, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.get(twilio.conversationURL("Services"), &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ConversationServicesResponse)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// DeleteConversationService will completely remove the conversation service matching the given identifier, along with all of its conversations, from within Twilio.
+func (twilio *Twilio) DeleteConversationService(serviceSID string) error {
+	res, err := twilio.delete(twilio.conversationURL("Services/" + serviceSID))
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		decoder := json.NewDecoder(res.Body)
+
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return err
+	}
+
+	return nil
+}
+
+// FetchConversationServiceConfiguration retrieves the configuration of the conversation service matching the given identifier from Twilio.
+func (twilio *Twilio) FetchConversationServiceConfiguration(serviceSID string) (*ConversationServiceConfiguration, error) {
+	res, err := twilio.get(twilio.conversationURL("Services/"+serviceSID+"/Configuration"), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ConversationServiceConfiguration)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// UpdateConversationServiceConfiguration will update the default roles and reachability settings of the conversation service matching the given identifier in Twilio.
+func (twilio *Twilio) UpdateConversationServiceConfiguration(serviceSID string, options UpdateConversationServiceConfigurationOptions) (*ConversationServiceConfiguration, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.conversationURL("Services/"+serviceSID+"/Configuration"), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ConversationServiceConfiguration)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// FetchConversationServiceNotifications retrieves the push notification settings of the conversation service matching the given identifier from Twilio.
+func (twilio *Twilio) FetchConversationServiceNotifications(serviceSID string) (*ConversationServiceNotifications, error) {
+	res, err := twilio.get(twilio.conversationURL("Services/"+serviceSID+"/Configuration/Notifications"), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ConversationServiceNotifications)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// UpdateConversationServiceNotifications will update the push notification settings of the conversation service matching the given identifier in Twilio.
+func (twilio *Twilio) UpdateConversationServiceNotifications(serviceSID string, options UpdateConversationServiceNotificationsOptions) (*ConversationServiceNotifications, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.conversationURL("Services/"+serviceSID+"/Configuration/Notifications"), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ConversationServiceNotifications)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// FetchConversationServiceWebhooks retrieves the webhook settings of the conversation service matching the given identifier from Twilio.
+func (twilio *Twilio) FetchConversationServiceWebhooks(serviceSID string) (*ConversationServiceWebhooks, error) {
+	res, err := twilio.get(twilio.conversationURL("Services/"+serviceSID+"/Configuration/Webhooks"), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ConversationServiceWebhooks)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// UpdateConversationServiceWebhooks will update the webhook settings of the conversation service matching the given identifier in Twilio.
+func (twilio *Twilio) UpdateConversationServiceWebhooks(serviceSID string, options UpdateConversationServiceWebhooksOptions) (*ConversationServiceWebhooks, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.conversationURL("Services/"+serviceSID+"/Configuration/Webhooks"), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ConversationServiceWebhooks)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// EncodeValues handles adding the given notification options to the request parameters using the dot notation (e.g. AddedToConversation.Enabled) that Twilio expects.
+func (options ConversationNotificationOptions) EncodeValues(key string, v *url.Values) error {
+	return encodeNestedValues(key, options, v)
+}
+
+// EncodeValues handles adding the given new message notification options to the request parameters using the dot notation (e.g. NewMessage.Enabled) that Twilio expects.
+func (options ConversationNewMessageNotificationOptions) EncodeValues(key string, v *url.Values) error {
+	return encodeNestedValues(key, options, v)
+}
+
+// EncodeValues handles adding the given media notification options to the request parameters using the dot notation (e.g. NewMessage.WithMedia.Enabled) that Twilio expects.
+func (options ConversationMediaNotificationOptions) EncodeValues(key string, v *url.Values) error {
+	return encodeNestedValues(key, options, v)
+}
@@ -0,0 +1,511 @@
+package twiligo_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	twiligo "github.com/craigpaul/twiligo/pkg"
+)
+
+const conversationServiceResponse = `{
+	"sid": "ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"account_sid": "ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"friendly_name": "Friendly Service",
+	"date_created": "2020-07-30T00:00:00Z",
+	"date_updated": "2020-07-30T00:00:00Z",
+	"url": "https://conversations.twilio.com/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"links": {
+		"conversations": "https://conversations.twilio.com/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Conversations",
+		"users": "https://conversations.twilio.com/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Users",
+		"roles": "https://conversations.twilio.com/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Roles",
+		"bindings": "https://conversations.twilio.com/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Bindings",
+		"configuration": "https://conversations.twilio.com/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Configuration",
+		"participant_conversations": "https://conversations.twilio.com/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/ParticipantConversations"
+	}
+}`
+
+const conversationServicesResponse = `{
+	"services": [
+		{
+			"sid": "ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"account_sid": "ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"friendly_name": "Friendly Service",
+			"date_created": "2020-07-30T00:00:00Z",
+			"date_updated": "2020-07-30T00:00:00Z",
+			"url": "https://conversations.twilio.com/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"links": {}
+		}
+	],
+	"meta": {
+		"page": 0,
+		"page_size": 1,
+		"first_page_url": "https://conversations.twilio.com/v1/Services?PageSize=1&Page=0",
+		"previous_page_url": null,
+		"url": "https://conversations.twilio.com/v1/Services?PageSize=1&Page=0",
+		"next_page_url": "https://conversations.twilio.com/v1/Services?PageSize=1&Page=1&PageToken=PAISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+		"key": "services"
+	}
+}`
+
+const conversationServiceConfigurationResponse = `{
+	"chat_service_sid": "ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"default_conversation_creator_role_sid": "RLXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"default_conversation_role_sid": "RLXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"default_chat_service_role_sid": "RLXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"reachability_enabled": true,
+	"url": "https://conversations.twilio.com/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Configuration",
+	"links": {
+		"notifications": "https://conversations.twilio.com/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Configuration/Notifications",
+		"webhooks": "https://conversations.twilio.com/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Configuration/Webhooks"
+	}
+}`
+
+const conversationServiceNotificationsResponse = `{
+	"account_sid": "ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"chat_service_sid": "ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"log_enabled": false,
+	"new_message": {
+		"enabled": true,
+		"template": "${PARTICIPANT}: ${MESSAGE}",
+		"sound": "ring",
+		"badge_count_enabled": true,
+		"with_media": {
+			"enabled": true,
+			"template": "${PARTICIPANT} sent media"
+		}
+	},
+	"added_to_conversation": {
+		"enabled": false,
+		"template": null,
+		"sound": null
+	},
+	"removed_from_conversation": {
+		"enabled": false,
+		"template": null,
+		"sound": null
+	},
+	"url": "https://conversations.twilio.com/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Configuration/Notifications"
+}`
+
+const conversationServiceWebhooksResponse = `{
+	"account_sid": "ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"chat_service_sid": "ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"pre_webhook_url": "https://example.com/pre",
+	"post_webhook_url": "https://example.com/post",
+	"filters": ["onMessageAdd", "onMessageAdded"],
+	"method": "POST",
+	"url": "https://conversations.twilio.com/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Configuration/Webhooks"
+}`
+
+const missingConversationServiceNameResponse = `{
+	"code": 20001,
+	"message": "Missing required parameter FriendlyName in the post body",
+	"more_info": "https://www.twilio.com/docs/errors/20001",
+	"status": 400
+}`
+
+func TestWillMakeRequestToCreateNewConversationServiceSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Services"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		if req.Header.Get("Authorization") == "" {
+			t.Log("Missing authorization credentials, they should be supplied via the Authorization header")
+			t.Fail()
+		}
+
+		if req.Header.Get("Content-Type") != "application/x-www-form-urlencoded" {
+			t.Logf("Incorrect content-type header supplied, expecting [%s], but received [%s]", "application/x-www-form-urlencoded", req.Header.Get("Content-Type"))
+			t.Fail()
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		if params.Get("FriendlyName") != "Friendly Service" {
+			t.Logf("Incorrect request parameter supplied, expecting [%s], but received [%s]", "Friendly Service", params.Get("FriendlyName"))
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(conversationServiceResponse)),
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.CreateNewConversationService("Friendly Service")
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+
+	if response == nil {
+		t.Log("Did not receive the expected response")
+		t.Fail()
+	}
+}
+
+func TestWillNotScopeConversationServiceRequestsToTheCurrentConversationService(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(conversationServiceResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	}).WithConversationService("ISYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY")
+
+	response, err := twilio.FetchConversationService("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX")
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+
+	if response == nil {
+		t.Log("Did not receive the expected response")
+		t.Fail()
+	}
+}
+
+func TestWillHandleErrorResponsesWhenMakingRequestToCreateNewConversationService(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(missingConversationServiceNameResponse)),
+			StatusCode: http.StatusBadRequest,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.CreateNewConversationService("")
+
+	if response != nil {
+		t.Logf("Response was incorrectly returned, was not expecting the following response: %v", response)
+		t.Fail()
+	}
+
+	expected := "Missing required parameter FriendlyName in the post body"
+
+	if err.Error() != expected {
+		t.Logf("Incorrect error returned, expected [%s], but received [%s]", expected, err)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToListConversationServicesSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Services"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		if req.URL.Query().Get("PageSize") != "1" {
+			t.Logf("Incorrect query parameter supplied, expecting [%s], but received [%s]", "1", req.URL.Query().Get("PageSize"))
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(conversationServicesResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.ListConversationServices(twiligo.PageOptions{PageSize: 1})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if len(response.Services) != 1 {
+		t.Logf("Incorrect number of services returned, expecting [%d], but received [%d]", 1, len(response.Services))
+		t.Fail()
+	}
+
+	if next := response.Meta.NextPage(); next == nil || next.PageToken != "PAISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX" {
+		t.Logf("Incorrect next page returned, received [%v]", next)
+		t.Fail()
+	}
+}
+
+func TestCanDeleteExistingConversationServiceSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		if req.Method != http.MethodDelete {
+			t.Logf("Incorrect method used, expecting [%s], but received [%s]", http.MethodDelete, req.Method)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(``)),
+			StatusCode: http.StatusNoContent,
+			Header:     make(http.Header),
+		}
+	})
+
+	err := twilio.DeleteConversationService("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX")
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+}
+
+func TestWillHandleErrorResponsesWhenMakingRequestToDeleteExistingConversationService(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(errorDeletingResourceResponse)),
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+		}
+	})
+
+	err := twilio.DeleteConversationService("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX")
+
+	expected := "The request resource was not found"
+
+	if err == nil || err.Error() != expected {
+		t.Logf("Incorrect error returned, expected [%s], but received [%v]", expected, err)
+		t.Fail()
+	}
+}
+
+func TestWillIncludeProperRequestBodyParametersWhenMakingRequestToUpdateConversationServiceConfigurationSuccessfully(t *testing.T) {
+	enabled := true
+
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Configuration"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		if params.Get("DefaultConversationRoleSid") != "RLXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX" {
+			t.Logf("Incorrect request parameter supplied, expecting [%s], but received [%s]", "RLXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", params.Get("DefaultConversationRoleSid"))
+			t.Fail()
+		}
+
+		if params.Get("ReachabilityEnabled") != "true" {
+			t.Logf("Incorrect request parameter supplied, expecting [%s], but received [%s]", "true", params.Get("ReachabilityEnabled"))
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(conversationServiceConfigurationResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.UpdateConversationServiceConfiguration("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.UpdateConversationServiceConfigurationOptions{
+		DefaultConversationRoleSID: "RLXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+		ReachabilityEnabled:        &enabled,
+	})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if response.ReachabilityEnabled == false {
+		t.Log("Incorrect reachability decoded, expecting it to be enabled")
+		t.Fail()
+	}
+}
+
+func TestWillIncludeProperRequestBodyParametersWhenMakingRequestToUpdateConversationServiceNotificationsSuccessfully(t *testing.T) {
+	enabled := true
+
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Configuration/Notifications"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		cases := map[string]string{
+			"NewMessage.Enabled":           "true",
+			"NewMessage.Template":          "${PARTICIPANT}: ${MESSAGE}",
+			"NewMessage.WithMedia.Enabled": "true",
+			"AddedToConversation.Sound":    "ring",
+		}
+
+		for key, expected := range cases {
+			if params.Get(key) != expected {
+				t.Logf("Incorrect request parameter supplied for [%s], expecting [%s], but received [%s]", key, expected, params.Get(key))
+				t.Fail()
+			}
+		}
+
+		if _, ok := params["RemovedFromConversation.Enabled"]; ok {
+			t.Log("Unexpected request parameter supplied for [RemovedFromConversation.Enabled]")
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(conversationServiceNotificationsResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.UpdateConversationServiceNotifications("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.UpdateConversationServiceNotificationsOptions{
+		NewMessage: twiligo.ConversationNewMessageNotificationOptions{
+			Enabled:  &enabled,
+			Template: "${PARTICIPANT}: ${MESSAGE}",
+			WithMedia: twiligo.ConversationMediaNotificationOptions{
+				Enabled: &enabled,
+			},
+		},
+		AddedToConversation: twiligo.ConversationNotificationOptions{
+			Sound: "ring",
+		},
+	})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if response.NewMessage.WithMedia.Enabled == false {
+		t.Log("Incorrect notification settings decoded, expecting media notifications to be enabled")
+		t.Fail()
+	}
+}
+
+func TestWillIncludeProperRequestBodyParametersWhenMakingRequestToUpdateConversationServiceWebhooksSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Configuration/Webhooks"
+
+		if strings.Contains(req.URL.Path, expected) == false {
+			t.Logf("Incorrect URL supplied, expecting URL to contain [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		if params.Get("PreWebhookUrl") != "https://example.com/pre" {
+			t.Logf("Incorrect request parameter supplied, expecting [%s], but received [%s]", "https://example.com/pre", params.Get("PreWebhookUrl"))
+			t.Fail()
+		}
+
+		if len(params["Filters"]) != 2 {
+			t.Logf("Incorrect number of filters supplied, expecting [%d], but received [%d]", 2, len(params["Filters"]))
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(conversationServiceWebhooksResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.UpdateConversationServiceWebhooks("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.UpdateConversationServiceWebhooksOptions{
+		PreWebhookURL: "https://example.com/pre",
+		Filters:       []string{"onMessageAdd", "onMessageAdded"},
+	})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if len(response.Filters) != 2 {
+		t.Logf("Incorrect number of filters decoded, expecting [%d], but received [%d]", 2, len(response.Filters))
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToFetchConversationServiceConfigurationSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		responses := map[string]string{
+			"/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Configuration":               conversationServiceConfigurationResponse,
+			"/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Configuration/Notifications": conversationServiceNotificationsResponse,
+			"/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Configuration/Webhooks":      conversationServiceWebhooksResponse,
+		}
+
+		response, ok := responses[req.URL.Path]
+
+		if ok == false {
+			t.Logf("Unexpected URL supplied, received [%s]", req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(response)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	configuration, err := twilio.FetchConversationServiceConfiguration("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX")
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+
+	if configuration == nil {
+		t.Log("Did not receive the expected configuration response")
+		t.Fail()
+	}
+
+	notifications, err := twilio.FetchConversationServiceNotifications("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX")
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+
+	if notifications == nil {
+		t.Log("Did not receive the expected notifications response")
+		t.Fail()
+	}
+
+	webhooks, err := twilio.FetchConversationServiceWebhooks("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX")
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+
+	if webhooks == nil {
+		t.Log("Did not receive the expected webhooks response")
+		t.Fail()
+	}
+}
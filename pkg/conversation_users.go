@@ -0,0 +1,362 @@
+package twiligo
+
+import (
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+// This constant is used to represent the level of push notifications a user will receive for a particular Conversation or Channel.
+const (
+	DefaultNotifications NotificationLevel = iota + 1
+	MutedNotifications
+)
+
+// ConversationUser represents a Twilio Conversations user identified by their unique Identity property within a Conversation Service.
+type ConversationUser struct {
+	SID            string    `json:"sid"`
+	AccountSID     string    `json:"account_sid"`
+	ChatServiceSID string    `json:"chat_service_sid"`
+	RoleSID        string    `json:"role_sid"`
+	Identity       string    `json:"identity"`
+	FriendlyName   *string   `json:"friendly_name"`
+	Attributes     string    `json:"attributes"`
+	IsOnline       *bool     `json:"is_online"`
+	IsNotifiable   *bool     `json:"is_notifiable"`
+	DateCreated    time.Time `json:"date_created"`
+	DateUpdated    time.Time `json:"date_updated"`
+	URL            string    `json:"url"`
+	Links          struct {
+		UserConversations string `json:"user_conversations"`
+	} `json:"links"`
+}
+
+// ConversationUserOptions are all of the options that can be provided to a CreateNewConversationUser or UpdateConversationUser call.
+type ConversationUserOptions struct {
+	FriendlyName string `url:",omitempty"`
+	Attributes   string `url:",omitempty"`
+	RoleSID      string `url:"RoleSid,omitempty"`
+}
+
+// ConversationUsersResponse is the representation of the JSON response from Twilio when listing conversation users.
+type ConversationUsersResponse struct {
+	Users []*ConversationUser `json:"users"`
+	Meta  Meta                `json:"meta"`
+}
+
+// NotificationLevel is used to define whether a user receives the default push notifications or has muted them.
+type NotificationLevel int
+
+// UpdateUserConversationOptions are all of the options that can be provided to an UpdateUserConversation call.
+type UpdateUserConversationOptions struct {
+	NotificationLevel    NotificationLevel `url:",omitempty"`
+	LastReadMessageIndex *int              `url:",omitempty"`
+	LastReadTimestamp    time.Time         `url:",omitempty"`
+}
+
+// UserConversation represents a single Conversation that a given ConversationUser is participating in, including their unread message count.
+type UserConversation struct {
+	AccountSID           string            `json:"account_sid"`
+	ChatServiceSID       string            `json:"chat_service_sid"`
+	ConversationSID      string            `json:"conversation_sid"`
+	UnreadMessagesCount  *int              `json:"unread_messages_count"`
+	LastReadMessageIndex *int              `json:"last_read_message_index"`
+	ParticipantSID       string            `json:"participant_sid"`
+	UserSID              string            `json:"user_sid"`
+	FriendlyName         *string           `json:"friendly_name"`
+	UniqueName           *string           `json:"unique_name"`
+	ConversationState    ConversationState `json:"conversation_state"`
+	Timers               struct {
+		DateInactive *time.Time `json:"date_inactive"`
+		DateClosed   *time.Time `json:"date_closed"`
+	} `json:"timers"`
+	Attributes        string            `json:"attributes"`
+	DateCreated       time.Time         `json:"date_created"`
+	DateUpdated       time.Time         `json:"date_updated"`
+	CreatedBy         string            `json:"created_by"`
+	NotificationLevel NotificationLevel `json:"notification_level"`
+	URL               string            `json:"url"`
+	Links             struct {
+		Participant  string `json:"participant"`
+		Conversation string `json:"conversation"`
+	} `json:"links"`
+}
+
+// UserConversationsResponse is the representation of the JSON response from Twilio when listing the conversations of a given user.
+type UserConversationsResponse struct {
+	Conversations []*UserConversation `json:"conversations"`
+	Meta          Meta                `json:"meta"`
+}
+
+// CreateNewConversationUser creates a new conversations user with the given identity in Twilio.
+func (twilio *Twilio) CreateNewConversationUser(identity string, options ConversationUserOptions) (*ConversationUser, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	params.Add("Identity", identity)
+
+	res, err := twilio.post(twilio.scopedConversationURL("Users"), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusCreated {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ConversationUser)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// FetchConversationUser retrieves the conversations user matching the given identifier, which may be either the SID or the Identity of the user, from Twilio.
+func (twilio *Twilio) FetchConversationUser(userSID string) (*ConversationUser, error) {
+	res, err := twilio.get(twilio.scopedConversationURL("Users/"+userSID), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ConversationUser)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// ListConversationUsers retrieves a single page of conversations users from Twilio.
+func (twilio *Twilio) ListConversationUsers(options PageOptions) (*ConversationUsersResponse, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.get(twilio.scopedConversationURL("Users"), &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ConversationUsersResponse)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// UpdateConversationUser will update an existing conversations user in Twilio based on the provided identifier and options.
+func (twilio *Twilio) UpdateConversationUser(userSID string, options ConversationUserOptions) (*ConversationUser, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.scopedConversationURL("Users/"+userSID), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ConversationUser)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// DeleteConversationUser will completely remove the conversations user matching the given identifier from within Twilio.
+func (twilio *Twilio) DeleteConversationUser(userSID string) error {
+	res, err := twilio.delete(twilio.scopedConversationURL("Users/" + userSID))
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		decoder := json.NewDecoder(res.Body)
+
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return err
+	}
+
+	return nil
+}
+
+// ListUserConversations retrieves a single page of the conversations that the given user is participating in, along with their unread message counts, from Twilio.
+func (twilio *Twilio) ListUserConversations(userSID string, options PageOptions) (*UserConversationsResponse, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.get(twilio.scopedConversationURL("Users/"+userSID+"/Conversations"), &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(UserConversationsResponse)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// FetchUserConversation retrieves a single conversation that the given user is participating in from Twilio.
+func (twilio *Twilio) FetchUserConversation(userSID, conversationSID string) (*UserConversation, error) {
+	res, err := twilio.get(twilio.scopedConversationURL("Users/"+userSID+"/Conversations/"+conversationSID), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(UserConversation)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// UpdateUserConversation will update the notification level or read horizon of the given user within the given conversation in Twilio.
+func (twilio *Twilio) UpdateUserConversation(userSID, conversationSID string, options UpdateUserConversationOptions) (*UserConversation, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.scopedConversationURL("Users/"+userSID+"/Conversations/"+conversationSID), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(UserConversation)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// MarshalJSON handles converting a NotificationLevel into the string representation used by Twilio.
+func (level NotificationLevel) MarshalJSON() ([]byte, error) {
+	return json.Marshal(level.String())
+}
+
+// UnmarshalJSON handles converting the string representation used by Twilio into a NotificationLevel.
+func (level *NotificationLevel) UnmarshalJSON(b []byte) error {
+	var s string
+
+	err := json.Unmarshal(b, &s)
+
+	if err != nil {
+		return err
+	}
+
+	*level = ConvertLevelToNotificationLevel(s)
+
+	return nil
+}
+
+func (level NotificationLevel) String() string {
+	return map[NotificationLevel]string{
+		DefaultNotifications: "default",
+		MutedNotifications:   "muted",
+	}[level]
+}
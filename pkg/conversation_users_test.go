@@ -0,0 +1,386 @@
+package twiligo_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+
+	twiligo "github.com/craigpaul/twiligo/pkg"
+)
+
+const conversationUserResponse = `{
+	"sid": "USXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"account_sid": "ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"chat_service_sid": "ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"role_sid": "RLXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"identity": "agent-1",
+	"friendly_name": "Agent One",
+	"attributes": "{}",
+	"is_online": true,
+	"is_notifiable": null,
+	"date_created": "2020-07-30T00:00:00Z",
+	"date_updated": "2020-07-30T00:00:00Z",
+	"url": "https://conversations.twilio.com/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Users/USXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"links": {
+		"user_conversations": "https://conversations.twilio.com/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Users/USXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Conversations"
+	}
+}`
+
+const userConversationsResponse = `{
+	"conversations": [
+		{
+			"account_sid": "ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"chat_service_sid": "ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"conversation_sid": "CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"unread_messages_count": 3,
+			"last_read_message_index": 5,
+			"participant_sid": "MBXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"user_sid": "USXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"friendly_name": "Friendly Conversation",
+			"unique_name": null,
+			"conversation_state": "inactive",
+			"timers": {
+				"date_inactive": "2020-07-30T00:10:00Z",
+				"date_closed": null
+			},
+			"attributes": "{}",
+			"date_created": "2020-07-30T00:00:00Z",
+			"date_updated": "2020-07-30T00:00:00Z",
+			"created_by": "agent-1",
+			"notification_level": "muted",
+			"url": "https://conversations.twilio.com/v1/Users/USXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"links": {
+				"participant": "https://conversations.twilio.com/v1/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Participants/MBXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+				"conversation": "https://conversations.twilio.com/v1/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+			}
+		}
+	],
+	"meta": {
+		"page": 0,
+		"page_size": 50,
+		"first_page_url": "https://conversations.twilio.com/v1/Users/USXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Conversations?PageSize=50&Page=0",
+		"previous_page_url": null,
+		"url": "https://conversations.twilio.com/v1/Users/USXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Conversations?PageSize=50&Page=0",
+		"next_page_url": null,
+		"key": "conversations"
+	}
+}`
+
+const userAlreadyExistsResponse = `{
+	"code": 50201,
+	"message": "User already exists",
+	"more_info": "https://www.twilio.com/docs/errors/50201",
+	"status": 409
+}`
+
+func TestWillMakeRequestToCreateNewConversationUserSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Users"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		if req.Header.Get("Authorization") == "" {
+			t.Log("Missing authorization credentials, they should be supplied via the Authorization header")
+			t.Fail()
+		}
+
+		if req.Header.Get("Content-Type") != "application/x-www-form-urlencoded" {
+			t.Logf("Incorrect content-type header supplied, expecting [%s], but received [%s]", "application/x-www-form-urlencoded", req.Header.Get("Content-Type"))
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(conversationUserResponse)),
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+		}
+	}).WithConversationService("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX")
+
+	response, err := twilio.CreateNewConversationUser("agent-1", twiligo.ConversationUserOptions{})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+
+	if response == nil {
+		t.Log("Did not receive the expected response")
+		t.Fail()
+	}
+}
+
+func TestWillIncludeProperRequestBodyParametersWhenMakingRequestToCreateNewConversationUserSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		cases := map[string]string{
+			"Identity":     "agent-1",
+			"FriendlyName": "Agent One",
+			"Attributes":   "{\"team\":\"support\"}",
+			"RoleSid":      "RLXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+		}
+
+		for key, expected := range cases {
+			if params.Get(key) != expected {
+				t.Logf("Incorrect request parameter supplied for [%s], expecting [%s], but received [%s]", key, expected, params.Get(key))
+				t.Fail()
+			}
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(conversationUserResponse)),
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+		}
+	})
+
+	twilio.CreateNewConversationUser("agent-1", twiligo.ConversationUserOptions{
+		FriendlyName: "Agent One",
+		Attributes:   "{\"team\":\"support\"}",
+		RoleSID:      "RLXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	})
+}
+
+func TestWillHandleErrorResponsesWhenMakingRequestToCreateNewConversationUser(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(userAlreadyExistsResponse)),
+			StatusCode: http.StatusConflict,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.CreateNewConversationUser("agent-1", twiligo.ConversationUserOptions{})
+
+	if response != nil {
+		t.Logf("Response was incorrectly returned, was not expecting the following response: %v", response)
+		t.Fail()
+	}
+
+	expected := "User already exists"
+
+	if err.Error() != expected {
+		t.Logf("Incorrect error returned, expected [%s], but received [%s]", expected, err)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToFetchConversationUserByIdentitySuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Users/agent-1"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(conversationUserResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.FetchConversationUser("agent-1")
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if response.Identity != "agent-1" {
+		t.Logf("Incorrect identity decoded, expecting [%s], but received [%s]", "agent-1", response.Identity)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToListConversationUsersSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Users"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"users": [` + conversationUserResponse + `], "meta": {"page": 0, "page_size": 50}}`)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.ListConversationUsers(twiligo.PageOptions{})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if len(response.Users) != 1 {
+		t.Logf("Incorrect number of users returned, expecting [%d], but received [%d]", 1, len(response.Users))
+		t.Fail()
+	}
+}
+
+func TestWillIncludeProperRequestBodyParametersWhenMakingRequestToUpdateConversationUserSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Users/USXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		if params.Get("RoleSid") != "RLYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY" {
+			t.Logf("Incorrect request parameter supplied, expecting [%s], but received [%s]", "RLYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY", params.Get("RoleSid"))
+			t.Fail()
+		}
+
+		if _, ok := params["Identity"]; ok {
+			t.Log("Unexpected request parameter supplied for [Identity]")
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(conversationUserResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.UpdateConversationUser("USXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.ConversationUserOptions{
+		RoleSID: "RLYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY",
+	})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+
+	if response == nil {
+		t.Log("Did not receive the expected response")
+		t.Fail()
+	}
+}
+
+func TestCanDeleteExistingConversationUserSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Users/USXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(``)),
+			StatusCode: http.StatusNoContent,
+			Header:     make(http.Header),
+		}
+	})
+
+	err := twilio.DeleteConversationUser("USXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX")
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToListUserConversationsSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Users/USXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Conversations"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(userConversationsResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.ListUserConversations("USXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.PageOptions{})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if len(response.Conversations) != 1 {
+		t.Logf("Incorrect number of conversations returned, expecting [%d], but received [%d]", 1, len(response.Conversations))
+		t.FailNow()
+	}
+
+	conversation := response.Conversations[0]
+
+	if conversation.UnreadMessagesCount == nil || *conversation.UnreadMessagesCount != 3 {
+		t.Logf("Incorrect unread messages count decoded, expecting [%d], but received [%v]", 3, conversation.UnreadMessagesCount)
+		t.Fail()
+	}
+
+	if conversation.NotificationLevel != twiligo.MutedNotifications {
+		t.Logf("Incorrect notification level decoded, expecting [%s], but received [%s]", twiligo.MutedNotifications, conversation.NotificationLevel)
+		t.Fail()
+	}
+
+	if conversation.ConversationState != twiligo.InactiveConversation {
+		t.Logf("Incorrect conversation state decoded, expecting [%s], but received [%s]", twiligo.InactiveConversation, conversation.ConversationState)
+		t.Fail()
+	}
+}
+
+func TestWillIncludeProperRequestBodyParametersWhenMakingRequestToUpdateUserConversationSuccessfully(t *testing.T) {
+	index := 0
+
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Users/USXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		if params.Get("NotificationLevel") != "muted" {
+			t.Logf("Incorrect request parameter supplied, expecting [%s], but received [%s]", "muted", params.Get("NotificationLevel"))
+			t.Fail()
+		}
+
+		if params.Get("LastReadMessageIndex") != "0" {
+			t.Logf("Incorrect request parameter supplied, expecting [%s], but received [%s]", "0", params.Get("LastReadMessageIndex"))
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(userConversationsResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	_, err := twilio.UpdateUserConversation("USXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.UpdateUserConversationOptions{
+		NotificationLevel:    twiligo.MutedNotifications,
+		LastReadMessageIndex: &index,
+	})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+}
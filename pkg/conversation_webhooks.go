@@ -0,0 +1,296 @@
+package twiligo
+
+import (
+	"errors"
+	"net/http"
+	"time"
+)
+
+// ConversationEvent is implemented by every typed Conversations webhook event returned from ParseConversationWebhook.
+type ConversationEvent interface {
+	ConversationEventType() string
+}
+
+// ConversationWebhookEvent holds the fields that Twilio includes with every Conversations webhook, regardless of the type of event.
+type ConversationWebhookEvent struct {
+	AccountSID     string `json:"AccountSid"`
+	ChatServiceSID string `json:"ChatServiceSid"`
+	EventType      string `json:"EventType"`
+	Source         string `json:"Source"`
+	ClientIdentity string `json:"ClientIdentity"`
+	RetryCount     int    `json:"RetryCount"`
+}
+
+// ConversationWebhookMedia describes a single piece of media attached to a message, as sent within Conversations webhooks.
+type ConversationWebhookMedia struct {
+	SID         string `json:"Sid"`
+	ContentType string `json:"ContentType"`
+	Filename    string `json:"Filename"`
+	Size        int    `json:"Size"`
+}
+
+// ConversationAddedEvent represents the onConversationAdded webhook sent from Twilio after a conversation has been created.
+type ConversationAddedEvent struct {
+	ConversationWebhookEvent
+	ConversationSID              string            `json:"ConversationSid"`
+	MessagingServiceSID          string            `json:"MessagingServiceSid"`
+	UniqueName                   string            `json:"UniqueName"`
+	FriendlyName                 string            `json:"FriendlyName"`
+	Attributes                   string            `json:"Attributes"`
+	State                        ConversationState `json:"State"`
+	MessagingBindingAddress      string            `json:"MessagingBinding.Address"`
+	MessagingBindingProxyAddress string            `json:"MessagingBinding.ProxyAddress"`
+	DateCreated                  time.Time         `json:"DateCreated"`
+}
+
+// ConversationUpdatedEvent represents the onConversationUpdated webhook sent from Twilio after a conversation has been updated.
+type ConversationUpdatedEvent struct {
+	ConversationWebhookEvent
+	ConversationSID     string            `json:"ConversationSid"`
+	MessagingServiceSID string            `json:"MessagingServiceSid"`
+	UniqueName          string            `json:"UniqueName"`
+	FriendlyName        string            `json:"FriendlyName"`
+	Attributes          string            `json:"Attributes"`
+	State               ConversationState `json:"State"`
+	DateCreated         time.Time         `json:"DateCreated"`
+	DateUpdated         time.Time         `json:"DateUpdated"`
+}
+
+// ConversationRemovedEvent represents the onConversationRemoved webhook sent from Twilio after a conversation has been deleted.
+type ConversationRemovedEvent struct {
+	ConversationWebhookEvent
+	ConversationSID     string            `json:"ConversationSid"`
+	MessagingServiceSID string            `json:"MessagingServiceSid"`
+	UniqueName          string            `json:"UniqueName"`
+	FriendlyName        string            `json:"FriendlyName"`
+	Attributes          string            `json:"Attributes"`
+	State               ConversationState `json:"State"`
+	DateCreated         time.Time         `json:"DateCreated"`
+	DateUpdated         time.Time         `json:"DateUpdated"`
+	DateRemoved         time.Time         `json:"DateRemoved"`
+}
+
+// ConversationStateUpdatedEvent represents the onConversationStateUpdated webhook sent from Twilio after a conversation has moved between the active, inactive and closed states.
+type ConversationStateUpdatedEvent struct {
+	ConversationWebhookEvent
+	ConversationSID     string            `json:"ConversationSid"`
+	MessagingServiceSID string            `json:"MessagingServiceSid"`
+	StateFrom           ConversationState `json:"StateFrom"`
+	StateTo             ConversationState `json:"StateTo"`
+	Reason              string            `json:"Reason"`
+	StateUpdated        time.Time         `json:"StateUpdated"`
+}
+
+// ConversationMessageAddedEvent represents the onMessageAdded webhook sent from Twilio after a message has been added to a conversation.
+type ConversationMessageAddedEvent struct {
+	ConversationWebhookEvent
+	ConversationSID     string                      `json:"ConversationSid"`
+	MessagingServiceSID string                      `json:"MessagingServiceSid"`
+	MessageSID          string                      `json:"MessageSid"`
+	Index               int                         `json:"Index"`
+	Author              string                      `json:"Author"`
+	Body                string                      `json:"Body"`
+	Media               []*ConversationWebhookMedia `json:"Media"`
+	Attributes          string                      `json:"Attributes"`
+	ParticipantSID      string                      `json:"ParticipantSid"`
+	DateCreated         time.Time                   `json:"DateCreated"`
+}
+
+// ConversationMessageUpdatedEvent represents the onMessageUpdated webhook sent from Twilio after a message within a conversation has been updated.
+type ConversationMessageUpdatedEvent struct {
+	ConversationWebhookEvent
+	ConversationSID     string                      `json:"ConversationSid"`
+	MessagingServiceSID string                      `json:"MessagingServiceSid"`
+	MessageSID          string                      `json:"MessageSid"`
+	Index               int                         `json:"Index"`
+	Author              string                      `json:"Author"`
+	Body                string                      `json:"Body"`
+	Media               []*ConversationWebhookMedia `json:"Media"`
+	Attributes          string                      `json:"Attributes"`
+	ParticipantSID      string                      `json:"ParticipantSid"`
+	DateCreated         time.Time                   `json:"DateCreated"`
+	DateUpdated         time.Time                   `json:"DateUpdated"`
+}
+
+// ConversationMessageRemovedEvent represents the onMessageRemoved webhook sent from Twilio after a message has been deleted from a conversation.
+type ConversationMessageRemovedEvent struct {
+	ConversationWebhookEvent
+	ConversationSID     string                      `json:"ConversationSid"`
+	MessagingServiceSID string                      `json:"MessagingServiceSid"`
+	MessageSID          string                      `json:"MessageSid"`
+	Index               int                         `json:"Index"`
+	Author              string                      `json:"Author"`
+	Body                string                      `json:"Body"`
+	Media               []*ConversationWebhookMedia `json:"Media"`
+	Attributes          string                      `json:"Attributes"`
+	ParticipantSID      string                      `json:"ParticipantSid"`
+	DateCreated         time.Time                   `json:"DateCreated"`
+	DateUpdated         time.Time                   `json:"DateUpdated"`
+	DateRemoved         time.Time                   `json:"DateRemoved"`
+}
+
+// ConversationParticipantAddedEvent represents the onParticipantAdded webhook sent from Twilio after a participant has been added to a conversation.
+type ConversationParticipantAddedEvent struct {
+	ConversationWebhookEvent
+	ConversationSID                  string    `json:"ConversationSid"`
+	MessagingServiceSID              string    `json:"MessagingServiceSid"`
+	ParticipantSID                   string    `json:"ParticipantSid"`
+	Identity                         string    `json:"Identity"`
+	RoleSID                          string    `json:"RoleSid"`
+	Attributes                       string    `json:"Attributes"`
+	MessagingBindingType             string    `json:"MessagingBinding.Type"`
+	MessagingBindingAddress          string    `json:"MessagingBinding.Address"`
+	MessagingBindingProxyAddress     string    `json:"MessagingBinding.ProxyAddress"`
+	MessagingBindingProjectedAddress string    `json:"MessagingBinding.ProjectedAddress"`
+	DateCreated                      time.Time `json:"DateCreated"`
+}
+
+// ConversationParticipantUpdatedEvent represents the onParticipantUpdated webhook sent from Twilio after a participant within a conversation has been updated.
+type ConversationParticipantUpdatedEvent struct {
+	ConversationWebhookEvent
+	ConversationSID                  string    `json:"ConversationSid"`
+	MessagingServiceSID              string    `json:"MessagingServiceSid"`
+	ParticipantSID                   string    `json:"ParticipantSid"`
+	Identity                         string    `json:"Identity"`
+	RoleSID                          string    `json:"RoleSid"`
+	Attributes                       string    `json:"Attributes"`
+	LastReadMessageIndex             *int      `json:"LastReadMessageIndex"`
+	MessagingBindingType             string    `json:"MessagingBinding.Type"`
+	MessagingBindingAddress          string    `json:"MessagingBinding.Address"`
+	MessagingBindingProxyAddress     string    `json:"MessagingBinding.ProxyAddress"`
+	MessagingBindingProjectedAddress string    `json:"MessagingBinding.ProjectedAddress"`
+	DateCreated                      time.Time `json:"DateCreated"`
+	DateUpdated                      time.Time `json:"DateUpdated"`
+}
+
+// ConversationParticipantRemovedEvent represents the onParticipantRemoved webhook sent from Twilio after a participant has been removed from a conversation.
+type ConversationParticipantRemovedEvent struct {
+	ConversationWebhookEvent
+	ConversationSID                  string    `json:"ConversationSid"`
+	MessagingServiceSID              string    `json:"MessagingServiceSid"`
+	ParticipantSID                   string    `json:"ParticipantSid"`
+	Identity                         string    `json:"Identity"`
+	RoleSID                          string    `json:"RoleSid"`
+	Attributes                       string    `json:"Attributes"`
+	MessagingBindingType             string    `json:"MessagingBinding.Type"`
+	MessagingBindingAddress          string    `json:"MessagingBinding.Address"`
+	MessagingBindingProxyAddress     string    `json:"MessagingBinding.ProxyAddress"`
+	MessagingBindingProjectedAddress string    `json:"MessagingBinding.ProjectedAddress"`
+	DateCreated                      time.Time `json:"DateCreated"`
+	DateUpdated                      time.Time `json:"DateUpdated"`
+	DateRemoved                      time.Time `json:"DateRemoved"`
+}
+
+// ConversationDeliveryUpdatedEvent represents the onDeliveryUpdated webhook sent from Twilio after the delivery status of a message sent to a non-chat participant has changed.
+type ConversationDeliveryUpdatedEvent struct {
+	ConversationWebhookEvent
+	ConversationSID    string    `json:"ConversationSid"`
+	MessageSID         string    `json:"MessageSid"`
+	ParticipantSID     string    `json:"ParticipantSid"`
+	DeliveryReceiptSID string    `json:"DeliveryReceiptSid"`
+	ChannelMessageSID  string    `json:"ChannelMessageSid"`
+	Status             string    `json:"Status"`
+	ErrorCode          *int      `json:"ErrorCode"`
+	DateCreated        time.Time `json:"DateCreated"`
+	DateUpdated        time.Time `json:"DateUpdated"`
+}
+
+// ConversationUserAddedEvent represents the onUserAdded webhook sent from Twilio after a conversation user has been created.
+type ConversationUserAddedEvent struct {
+	ConversationWebhookEvent
+	UserSID      string    `json:"UserSid"`
+	Identity     string    `json:"Identity"`
+	FriendlyName string    `json:"FriendlyName"`
+	Attributes   string    `json:"Attributes"`
+	RoleSID      string    `json:"RoleSid"`
+	DateCreated  time.Time `json:"DateCreated"`
+}
+
+// ConversationUserUpdatedEvent represents the onUserUpdated webhook sent from Twilio after a conversation user has been updated.
+type ConversationUserUpdatedEvent struct {
+	ConversationWebhookEvent
+	UserSID      string    `json:"UserSid"`
+	Identity     string    `json:"Identity"`
+	FriendlyName string    `json:"FriendlyName"`
+	Attributes   string    `json:"Attributes"`
+	RoleSID      string    `json:"RoleSid"`
+	IsOnline     bool      `json:"IsOnline"`
+	IsNotifiable bool      `json:"IsNotifiable"`
+	DateCreated  time.Time `json:"DateCreated"`
+	DateUpdated  time.Time `json:"DateUpdated"`
+}
+
+// ConversationEventType returns the EventType (e.g. onMessageAdded) that the webhook was sent for.
+func (event ConversationWebhookEvent) ConversationEventType() string {
+	return event.EventType
+}
+
+// ParseConversationWebhook decodes the form body of the given Conversations webhook request into the typed event matching its EventType, e.g. a *ConversationMessageAddedEvent for onMessageAdded or a *ConversationMessageAddEvent for the onMessageAdd pre-event.
+func ParseConversationWebhook(r *http.Request) (ConversationEvent, error) {
+	err := r.ParseForm()
+
+	if err != nil {
+		return nil, err
+	}
+
+	var event ConversationEvent
+
+	switch eventType := r.PostForm.Get("EventType"); eventType {
+	case "onConversationAdd":
+		event = new(ConversationAddEvent)
+	case "onConversationUpdate":
+		event = new(ConversationUpdateEvent)
+	case "onConversationRemove":
+		event = new(ConversationRemoveEvent)
+	case "onMessageAdd":
+		event = new(ConversationMessageAddEvent)
+	case "onMessageUpdate":
+		event = new(ConversationMessageUpdateEvent)
+	case "onMessageRemove":
+		event = new(ConversationMessageRemoveEvent)
+	case "onParticipantAdd":
+		event = new(ConversationParticipantAddEvent)
+	case "onParticipantUpdate":
+		event = new(ConversationParticipantUpdateEvent)
+	case "onParticipantRemove":
+		event = new(ConversationParticipantRemoveEvent)
+	case "onConversationAdded":
+		event = new(ConversationAddedEvent)
+	case "onConversationUpdated":
+		event = new(ConversationUpdatedEvent)
+	case "onConversationRemoved":
+		event = new(ConversationRemovedEvent)
+	case "onConversationStateUpdated":
+		event = new(ConversationStateUpdatedEvent)
+	case "onMessageAdded":
+		event = new(ConversationMessageAddedEvent)
+	case "onMessageUpdated":
+		event = new(ConversationMessageUpdatedEvent)
+	case "onMessageRemoved":
+		event = new(ConversationMessageRemovedEvent)
+	case "onParticipantAdded":
+		event = new(ConversationParticipantAddedEvent)
+	case "onParticipantUpdated":
+		event = new(ConversationParticipantUpdatedEvent)
+	case "onParticipantRemoved":
+		event = new(ConversationParticipantRemovedEvent)
+	case "onDeliveryUpdated":
+		event = new(ConversationDeliveryUpdatedEvent)
+	case "onUserAdded":
+		event = new(ConversationUserAddedEvent)
+	case "onUserUpdated":
+		event = new(ConversationUserUpdatedEvent)
+	case "":
+		return nil, errors.New("Missing required parameter EventType")
+	default:
+		return nil, errors.New("Unsupported conversation webhook EventType " + eventType)
+	}
+
+	err = decodeWebhookValues(r.PostForm, event)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return event, nil
+}
@@ -0,0 +1,149 @@
+package twiligo_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	twiligo "github.com/craigpaul/twiligo/pkg"
+)
+
+func NewTestWebhookRequest(values url.Values) *http.Request {
+	req := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader(values.Encode()))
+
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	return req
+}
+
+func TestWillParseMessageAddedConversationWebhook(t *testing.T) {
+	req := NewTestWebhookRequest(url.Values{
+		"AccountSid":      {"ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"},
+		"EventType":       {"onMessageAdded"},
+		"Source":          {"SMS"},
+		"ConversationSid": {"CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"},
+		"MessageSid":      {"IMXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"},
+		"Index":           {"3"},
+		"Author":          {"+15555555555"},
+		"Body":            {"Hello"},
+		"Media":           {`[{"Sid": "MEXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "ContentType": "image/png", "Filename": "receipt.png", "Size": 4}]`},
+		"DateCreated":     {"2020-07-30T00:00:00.000Z"},
+	})
+
+	event, err := twiligo.ParseConversationWebhook(req)
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	message, ok := event.(*twiligo.ConversationMessageAddedEvent)
+
+	if ok == false {
+		t.Logf("Incorrect event returned, expected [%T], but received [%T]", message, event)
+		t.FailNow()
+	}
+
+	if message.ConversationEventType() != "onMessageAdded" || message.Source != "SMS" {
+		t.Logf("Incorrect common fields decoded, received [%v]", message.ConversationWebhookEvent)
+		t.Fail()
+	}
+
+	if message.Index != 3 || message.Body != "Hello" || message.DateCreated.Year() != 2020 {
+		t.Logf("Incorrect message fields decoded, received [%v]", message)
+		t.Fail()
+	}
+
+	if len(message.Media) != 1 || message.Media[0].ContentType != "image/png" {
+		t.Logf("Incorrect media decoded, received [%v]", message.Media)
+		t.Fail()
+	}
+}
+
+func TestWillParseStateUpdatedConversationWebhook(t *testing.T) {
+	req := NewTestWebhookRequest(url.Values{
+		"EventType":       {"onConversationStateUpdated"},
+		"ConversationSid": {"CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"},
+		"StateFrom":       {"active"},
+		"StateTo":         {"inactive"},
+		"Reason":          {"TIMER"},
+	})
+
+	event, err := twiligo.ParseConversationWebhook(req)
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	state, ok := event.(*twiligo.ConversationStateUpdatedEvent)
+
+	if ok == false || state.StateFrom != twiligo.ActiveConversation || state.StateTo != twiligo.InactiveConversation {
+		t.Logf("Incorrect event decoded, received [%v]", event)
+		t.Fail()
+	}
+}
+
+func TestWillParseParticipantAndDeliveryConversationWebhooks(t *testing.T) {
+	req := NewTestWebhookRequest(url.Values{
+		"EventType":                     {"onParticipantAdded"},
+		"ParticipantSid":                {"MBXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"},
+		"MessagingBinding.Address":      {"+15555555555"},
+		"MessagingBinding.ProxyAddress": {"+16666666666"},
+	})
+
+	event, _ := twiligo.ParseConversationWebhook(req)
+
+	if participant, ok := event.(*twiligo.ConversationParticipantAddedEvent); ok == false || participant.MessagingBindingProxyAddress != "+16666666666" {
+		t.Logf("Incorrect event decoded, received [%v]", event)
+		t.Fail()
+	}
+
+	req = NewTestWebhookRequest(url.Values{
+		"EventType":  {"onDeliveryUpdated"},
+		"MessageSid": {"IMXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"},
+		"Status":     {"undelivered"},
+		"ErrorCode":  {"30003"},
+	})
+
+	event, _ = twiligo.ParseConversationWebhook(req)
+
+	if delivery, ok := event.(*twiligo.ConversationDeliveryUpdatedEvent); ok == false || delivery.ErrorCode == nil || *delivery.ErrorCode != 30003 {
+		t.Logf("Incorrect event decoded, received [%v]", event)
+		t.Fail()
+	}
+}
+
+func TestWillReturnErrorWhenParsingUnsupportedConversationWebhook(t *testing.T) {
+	req := NewTestWebhookRequest(url.Values{"EventType": {"onSomethingElse"}})
+
+	event, err := twiligo.ParseConversationWebhook(req)
+
+	if event != nil {
+		t.Logf("Event was incorrectly returned, was not expecting the following event: %v", event)
+		t.Fail()
+	}
+
+	expected := "Unsupported conversation webhook EventType onSomethingElse"
+
+	if err == nil || err.Error() != expected {
+		t.Logf("Incorrect error returned, expected [%s], but received [%v]", expected, err)
+		t.Fail()
+	}
+}
+
+func TestWillReturnErrorWhenParsingConversationWebhookWithInvalidValue(t *testing.T) {
+	req := NewTestWebhookRequest(url.Values{
+		"EventType": {"onMessageAdded"},
+		"Index":     {"three"},
+	})
+
+	_, err := twiligo.ParseConversationWebhook(req)
+
+	if err == nil || strings.HasPrefix(err.Error(), "Invalid value provided for Index") == false {
+		t.Logf("Incorrect error returned, expected an invalid Index error, but received [%v]", err)
+		t.Fail()
+	}
+}
@@ -3,37 +3,48 @@ package twiligo
 import (
 	"encoding/json"
 	"net/http"
+	"net/url"
 	"time"
 
+	dates "github.com/craigpaul/twiligo/internal"
 	"github.com/google/go-querystring/query"
 )
 
+// This constant is used to represent the current state of a particular Conversation.
+const (
+	ActiveConversation ConversationState = iota + 1
+	InactiveConversation
+	ClosedConversation
+)
+
 // ConversationOptions are all of the options that can be provided to a CreateNewConversation call.
 type ConversationOptions struct {
-	FriendlyName        string    `url:",omitempty"`
-	DateCreated         time.Time `url:",omitempty"`
-	DateUpdated         time.Time `url:",omitempty"`
-	MessagingServiceSID string    `url:"MessagingServiceSid,omitempty"`
-	Attributes          string    `url:",omitempty"`
-	State               string    `url:",omitempty"`
-	InactiveTimer       string    `url:"Timers.Inactive,omitempty"`
-	ClosedTimer         string    `url:"Timers.Closed,omitempty"`
+	FriendlyName        string            `url:",omitempty"`
+	UniqueName          string            `url:",omitempty"`
+	DateCreated         time.Time         `url:",omitempty"`
+	DateUpdated         time.Time         `url:",omitempty"`
+	MessagingServiceSID string            `url:"MessagingServiceSid,omitempty"`
+	Attributes          string            `url:",omitempty"`
+	State               ConversationState `url:",omitempty"`
+	InactiveTimer       time.Duration     `url:"-"`
+	ClosedTimer         time.Duration     `url:"-"`
 }
 
 // Conversation represents a Twilio conversation between two or more connected participants.
 type Conversation struct {
-	SID                 string    `json:"sid"`
-	AccountSID          string    `json:"account_sid"`
-	ChatServiceSID      string    `json:"chat_service_sid"`
-	MessagingServiceSID string    `json:"messaging_service_sid"`
-	FriendlyName        *string   `json:"friendly_name"`
-	Attributes          string    `json:"attributes"`
-	DateCreated         time.Time `json:"date_created"`
-	DateUpdated         time.Time `json:"date_updated"`
-	State               string    `json:"state"`
+	SID                 string            `json:"sid"`
+	AccountSID          string            `json:"account_sid"`
+	ChatServiceSID      string            `json:"chat_service_sid"`
+	MessagingServiceSID string            `json:"messaging_service_sid"`
+	FriendlyName        *string           `json:"friendly_name"`
+	UniqueName          *string           `json:"unique_name"`
+	Attributes          string            `json:"attributes"`
+	DateCreated         time.Time         `json:"date_created"`
+	DateUpdated         time.Time         `json:"date_updated"`
+	State               ConversationState `json:"state"`
 	Timers              struct {
-		DateInactive string `json:"date_inactive"`
-		DateClosed   string `json:"date_closed"`
+		DateInactive *time.Time `json:"date_inactive"`
+		DateClosed   *time.Time `json:"date_closed"`
 	} `json:"timers"`
 	Links struct {
 		Participants string `json:"participants"`
@@ -43,15 +54,24 @@ type Conversation struct {
 	URL string `json:"url"`
 }
 
+// ConversationsResponse is the representation of the JSON response from Twilio when listing conversations.
+type ConversationsResponse struct {
+	Conversations []*Conversation `json:"conversations"`
+	Meta          Meta            `json:"meta"`
+}
+
+// ConversationState is used to define whether a particular Conversation is active, inactive or closed.
+type ConversationState int
+
 // CreateNewConversation creates a new Conversation in Twilio with the provided options.
 func (twilio *Twilio) CreateNewConversation(options ConversationOptions) (*Conversation, error) {
-	params, err := query.Values(options)
+	params, err := options.values()
 
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := twilio.post(twilio.conversationURL("Conversations"), params)
+	res, err := twilio.post(twilio.scopedConversationURL("Conversations"), params)
 
 	if err != nil {
 		return nil, err
@@ -76,15 +96,75 @@ func (twilio *Twilio) CreateNewConversation(options ConversationOptions) (*Conve
 	return response, nil
 }
 
+// FetchConversation retrieves the conversation matching the given identifier from Twilio.
+func (twilio *Twilio) FetchConversation(conversationSID string) (*Conversation, error) {
+	res, err := twilio.get(twilio.scopedConversationURL("Conversations/"+conversationSID), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(Conversation)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// ListConversations retrieves a single page of conversations from Twilio.
+func (twilio *Twilio) ListConversations(options PageOptions) (*ConversationsResponse, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.get(twilio.scopedConversationURL("Conversations"), &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ConversationsResponse)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
 // UpdateConversation will update an existing conversation in Twilio based on the provided identifier and options.
 func (twilio *Twilio) UpdateConversation(conversationSID string, options ConversationOptions) (*Conversation, error) {
-	params, err := query.Values(options)
+	params, err := options.values()
 
 	if err != nil {
 		return nil, err
 	}
 
-	res, err := twilio.post(twilio.conversationURL("Conversations/"+conversationSID), params)
+	res, err := twilio.post(twilio.scopedConversationURL("Conversations/"+conversationSID), params)
 
 	if err != nil {
 		return nil, err
@@ -111,10 +191,10 @@ func (twilio *Twilio) UpdateConversation(conversationSID string, options Convers
 
 // DeleteConversation will completely remove the conversation matching the given identifier from within Twilio.
 func (twilio *Twilio) DeleteConversation(conversationSID string) error {
-	res, err := twilio.delete(twilio.conversationURL("Conversations/" + conversationSID))
+	res, err := twilio.delete(twilio.scopedConversationURL("Conversations/" + conversationSID))
 
 	if err != nil {
-		return nil
+		return err
 	}
 
 	defer res.Body.Close()
@@ -131,3 +211,49 @@ func (twilio *Twilio) DeleteConversation(conversationSID string) error {
 
 	return nil
 }
+
+func (options ConversationOptions) values() (url.Values, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if options.InactiveTimer > 0 {
+		dates.Iso8601Duration{Duration: options.InactiveTimer}.EncodeValues("Timers.Inactive", &params)
+	}
+
+	if options.ClosedTimer > 0 {
+		dates.Iso8601Duration{Duration: options.ClosedTimer}.EncodeValues("Timers.Closed", &params)
+	}
+
+	return params, nil
+}
+
+// MarshalJSON handles converting a ConversationState into the string representation used by Twilio.
+func (state ConversationState) MarshalJSON() ([]byte, error) {
+	return json.Marshal(state.String())
+}
+
+// UnmarshalJSON handles converting the string representation used by Twilio into a ConversationState.
+func (state *ConversationState) UnmarshalJSON(b []byte) error {
+	var s string
+
+	err := json.Unmarshal(b, &s)
+
+	if err != nil {
+		return err
+	}
+
+	*state = ConvertStateToConversationState(s)
+
+	return nil
+}
+
+func (state ConversationState) String() string {
+	return map[ConversationState]string{
+		ActiveConversation:   "active",
+		InactiveConversation: "inactive",
+		ClosedConversation:   "closed",
+	}[state]
+}
@@ -41,7 +41,10 @@ const createdConversationResponse = `{
 const updatedConversationResponse = `{
 	"date_updated": "2020-07-30T00:00:00Z",
 	"friendly_name": "Friendly Conversation",
-	"timers": {},
+	"timers": {
+		"date_inactive": "2020-07-30T00:10:00Z",
+		"date_closed": "2020-07-31T00:00:00Z"
+	},
 	"account_sid": "ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
 	"url": "https://conversations.twilio.com/v1/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
 	"state": "closed",
@@ -96,6 +99,35 @@ func TestWillMakeRequestToCreateNewConversationSuccessfully(t *testing.T) {
 	}
 }
 
+func TestWillMakeRequestToCreateNewConversationWithinConversationServiceSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Conversations"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(createdConversationResponse)),
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+		}
+	}).WithConversationService("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX")
+
+	response, err := twilio.CreateNewConversation(twiligo.ConversationOptions{})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+
+	if response == nil {
+		t.Log("Did not receive the expected response")
+		t.Fail()
+	}
+}
+
 func TestWillIncludeProperRequestBodyParametersWhenMakingRequestToCreateNewConversationSuccessfully(t *testing.T) {
 	now := time.Now()
 	attributes := "{\"custom\":\"value\"}"
@@ -151,9 +183,9 @@ func TestWillIncludeProperRequestBodyParametersWhenMakingRequestToCreateNewConve
 		DateCreated:   now,
 		DateUpdated:   now,
 		Attributes:    attributes,
-		State:         "active",
-		InactiveTimer: "PT10M",
-		ClosedTimer:   "PT1H30M",
+		State:         twiligo.ActiveConversation,
+		InactiveTimer: time.Minute * 10,
+		ClosedTimer:   time.Hour + time.Minute*30,
 	})
 }
 
@@ -218,6 +250,25 @@ func TestWillMakeRequestToUpdateConversationSuccessfully(t *testing.T) {
 
 	if response == nil {
 		t.Log("Did not receive the expected response")
+		t.FailNow()
+	}
+
+	if response.State != twiligo.ClosedConversation {
+		t.Logf("Incorrect state decoded, expecting [%s], but received [%s]", twiligo.ClosedConversation, response.State)
+		t.Fail()
+	}
+
+	expected := time.Date(2020, time.July, 30, 0, 10, 0, 0, time.UTC)
+
+	if response.Timers.DateInactive == nil || response.Timers.DateInactive.Equal(expected) == false {
+		t.Logf("Incorrect inactive date decoded, expecting [%s], but received [%v]", expected, response.Timers.DateInactive)
+		t.Fail()
+	}
+
+	expected = time.Date(2020, time.July, 31, 0, 0, 0, 0, time.UTC)
+
+	if response.Timers.DateClosed == nil || response.Timers.DateClosed.Equal(expected) == false {
+		t.Logf("Incorrect closed date decoded, expecting [%s], but received [%v]", expected, response.Timers.DateClosed)
 		t.Fail()
 	}
 }
@@ -240,7 +291,7 @@ func TestWillIncludeProperRequestBodyParametersWhenMakingRequestToUpdateConversa
 	})
 
 	twilio.CreateNewConversation(twiligo.ConversationOptions{
-		State: "closed",
+		State: twiligo.ClosedConversation,
 	})
 }
 
@@ -317,3 +368,76 @@ func TestWillHandleErrorResponsesWhenMakingRequestToDeleteExistingConversation(t
 		t.Fail()
 	}
 }
+
+func TestWillMakeRequestToFetchConversationSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		if req.Method != http.MethodGet {
+			t.Logf("Incorrect method used, expecting [%s], but received [%s]", http.MethodGet, req.Method)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(createdConversationResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.FetchConversation("CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX")
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if response.State != twiligo.ActiveConversation {
+		t.Logf("Incorrect state decoded, expecting [%s], but received [%s]", twiligo.ActiveConversation, response.State)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToListConversationsWithinConversationServiceSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Services/ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Conversations"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		if req.URL.Query().Get("PageSize") != "50" {
+			t.Logf("Incorrect query parameter supplied, expecting [%s], but received [%s]", "50", req.URL.Query().Get("PageSize"))
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"conversations": [` + createdConversationResponse + `], "meta": {"page": 0, "page_size": 50, "next_page_url": null}}`)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	}).WithConversationService("ISXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX")
+
+	response, err := twilio.ListConversations(twiligo.PageOptions{PageSize: 50})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if len(response.Conversations) != 1 {
+		t.Logf("Incorrect number of conversations returned, expecting [%d], but received [%d]", 1, len(response.Conversations))
+		t.Fail()
+	}
+
+	if response.Meta.NextPage() != nil {
+		t.Log("Incorrect next page returned, expecting to be on the last page")
+		t.Fail()
+	}
+}
@@ -10,6 +10,84 @@ func ConvertDirectionToMessageDirection(direction string) MessageDirection {
 	}[direction]
 }
 
+// ConvertLevelToNotificationLevel ...
+func ConvertLevelToNotificationLevel(level string) NotificationLevel {
+	return map[string]NotificationLevel{
+		"default": DefaultNotifications,
+		"muted":   MutedNotifications,
+	}[level]
+}
+
+// ConvertModeToProxySessionMode ...
+func ConvertModeToProxySessionMode(mode string) ProxySessionMode {
+	return map[string]ProxySessionMode{
+		"message-only":      MessageOnlySession,
+		"voice-only":        VoiceOnlySession,
+		"voice-and-message": VoiceAndMessageSession,
+	}[mode]
+}
+
+// ConvertPermissionToChatPermission ...
+func ConvertPermissionToChatPermission(permission string) ChatPermission {
+	return map[string]ChatPermission{
+		"createChannel":            ChatCreateChannelPermission,
+		"joinChannel":              ChatJoinChannelPermission,
+		"editOwnUserInfo":          ChatEditOwnUserInfoPermission,
+		"editAnyUserInfo":          ChatEditAnyUserInfoPermission,
+		"addMember":                ChatAddMemberPermission,
+		"inviteMember":             ChatInviteMemberPermission,
+		"removeMember":             ChatRemoveMemberPermission,
+		"leaveChannel":             ChatLeaveChannelPermission,
+		"destroyChannel":           ChatDestroyChannelPermission,
+		"editChannelName":          ChatEditChannelNamePermission,
+		"editChannelAttributes":    ChatEditChannelAttributesPermission,
+		"sendMessage":              ChatSendMessagePermission,
+		"sendMediaMessage":         ChatSendMediaMessagePermission,
+		"editOwnMessage":           ChatEditOwnMessagePermission,
+		"editAnyMessage":           ChatEditAnyMessagePermission,
+		"editOwnMessageAttributes": ChatEditOwnMessageAttributesPermission,
+		"editAnyMessageAttributes": ChatEditAnyMessageAttributesPermission,
+		"deleteOwnMessage":         ChatDeleteOwnMessagePermission,
+		"deleteAnyMessage":         ChatDeleteAnyMessagePermission,
+	}[permission]
+}
+
+// ConvertPermissionToConversationPermission ...
+func ConvertPermissionToConversationPermission(permission string) ConversationPermission {
+	return map[string]ConversationPermission{
+		"createConversation":           ConversationCreateConversationPermission,
+		"joinConversation":             ConversationJoinConversationPermission,
+		"editOwnUserInfo":              ConversationEditOwnUserInfoPermission,
+		"editAnyUserInfo":              ConversationEditAnyUserInfoPermission,
+		"addParticipant":               ConversationAddParticipantPermission,
+		"removeParticipant":            ConversationRemoveParticipantPermission,
+		"leaveConversation":            ConversationLeaveConversationPermission,
+		"deleteConversation":           ConversationDeleteConversationPermission,
+		"editConversationName":         ConversationEditConversationNamePermission,
+		"editConversationAttributes":   ConversationEditConversationAttributesPermission,
+		"editNotificationLevel":        ConversationEditNotificationLevelPermission,
+		"sendMessage":                  ConversationSendMessagePermission,
+		"sendMediaMessage":             ConversationSendMediaMessagePermission,
+		"editOwnMessage":               ConversationEditOwnMessagePermission,
+		"editAnyMessage":               ConversationEditAnyMessagePermission,
+		"editOwnMessageAttributes":     ConversationEditOwnMessageAttributesPermission,
+		"editAnyMessageAttributes":     ConversationEditAnyMessageAttributesPermission,
+		"deleteOwnMessage":             ConversationDeleteOwnMessagePermission,
+		"deleteAnyMessage":             ConversationDeleteAnyMessagePermission,
+		"editOwnParticipantAttributes": ConversationEditOwnParticipantAttributesPermission,
+		"editAnyParticipantAttributes": ConversationEditAnyParticipantAttributesPermission,
+	}[permission]
+}
+
+// ConvertStateToConversationState ...
+func ConvertStateToConversationState(state string) ConversationState {
+	return map[string]ConversationState{
+		"active":   ActiveConversation,
+		"inactive": InactiveConversation,
+		"closed":   ClosedConversation,
+	}[state]
+}
+
 // ConvertStatusToMessageStatus ...
 func ConvertStatusToMessageStatus(status string) MessageStatus {
 	return map[string]MessageStatus{
@@ -25,6 +103,105 @@ func ConvertStatusToMessageStatus(status string) MessageStatus {
 	}[status]
 }
 
+// ConvertStatusToProxyResourceStatus ...
+func ConvertStatusToProxyResourceStatus(status string) ProxyResourceStatus {
+	return map[string]ProxyResourceStatus{
+		"accepted":         AcceptedResource,
+		"answered":         AnsweredResource,
+		"busy":             BusyResource,
+		"canceled":         CanceledResource,
+		"completed":        CompletedResource,
+		"deleted":          DeletedResource,
+		"delivered":        DeliveredResource,
+		"delivery-unknown": DeliveryUnknownResource,
+		"failed":           FailedResource,
+		"in-progress":      InProgressResource,
+		"initiated":        InitiatedResource,
+		"no-answer":        NoAnswerResource,
+		"queued":           QueuedResource,
+		"received":         ReceivedResource,
+		"receiving":        ReceivingResource,
+		"ringing":          RingingResource,
+		"scheduled":        ScheduledResource,
+		"sending":          SendingResource,
+		"sent":             SentResource,
+		"undelivered":      UndeliveredResource,
+		"unknown":          UnknownResource,
+	}[status]
+}
+
+// ConvertStatusToProxySessionStatus ...
+func ConvertStatusToProxySessionStatus(status string) ProxySessionStatus {
+	return map[string]ProxySessionStatus{
+		"open":        OpenSession,
+		"in-progress": InProgressSession,
+		"closed":      ClosedSession,
+		"failed":      FailedSession,
+		"unknown":     UnknownSession,
+	}[status]
+}
+
+// ConvertTypeToAddressType ...
+func ConvertTypeToAddressType(addressType string) AddressType {
+	return map[string]AddressType{
+		"sms":       SMSAddress,
+		"whatsapp":  WhatsAppAddress,
+		"messenger": MessengerAddress,
+		"gbm":       GBMAddress,
+	}[addressType]
+}
+
+// ConvertTypeToAutoCreationType ...
+func ConvertTypeToAutoCreationType(autoCreationType string) AutoCreationType {
+	return map[string]AutoCreationType{
+		"default": DefaultAutoCreation,
+		"webhook": WebhookAutoCreation,
+		"studio":  StudioAutoCreation,
+	}[autoCreationType]
+}
+
+// ConvertTypeToChatChannelType ...
+func ConvertTypeToChatChannelType(channelType string) ChatChannelType {
+	return map[string]ChatChannelType{
+		"public":  PublicChatChannel,
+		"private": PrivateChatChannel,
+	}[channelType]
+}
+
+// ConvertTypeToChatCredentialType ...
+func ConvertTypeToChatCredentialType(credentialType string) ChatCredentialType {
+	return map[string]ChatCredentialType{
+		"apn": APNChatCredential,
+		"fcm": FCMChatCredential,
+		"gcm": GCMChatCredential,
+	}[credentialType]
+}
+
+// ConvertTypeToChatRoleType ...
+func ConvertTypeToChatRoleType(roleType string) ChatRoleType {
+	return map[string]ChatRoleType{
+		"channel":    ChannelChatRole,
+		"deployment": DeploymentChatRole,
+	}[roleType]
+}
+
+// ConvertTypeToConversationRoleType ...
+func ConvertTypeToConversationRoleType(roleType string) ConversationRoleType {
+	return map[string]ConversationRoleType{
+		"conversation": ConversationScopedRole,
+		"service":      ConversationServiceScopedRole,
+	}[roleType]
+}
+
+// ConvertTypeToProxyInteractionType ...
+func ConvertTypeToProxyInteractionType(interactionType string) ProxyInteractionType {
+	return map[string]ProxyInteractionType{
+		"message": MessageProxyInteraction,
+		"voice":   VoiceProxyInteraction,
+		"unknown": UnknownProxyInteraction,
+	}[interactionType]
+}
+
 // GetPhoneNumberType will convert a given integer into a PhoneNumberType by the given country. Certain countries do not support all PhoneNumberType values, so this function can be used as a safe mapping based on the values from this document https://support.twilio.com/hc/en-us/articles/223183068-Twilio-international-phone-number-availability-and-their-capabilities. Note: Not all cases are currently supported, but can be amended as necessary.
 func GetPhoneNumberType(number int, country string) PhoneNumberType {
 	numberType := PhoneNumberType(number)
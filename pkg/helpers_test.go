@@ -24,6 +24,211 @@ func TestWillConvertGivenDirectionStringToMatchingMessageDirection(t *testing.T)
 	}
 }
 
+func TestWillConvertGivenLevelStringToMatchingNotificationLevel(t *testing.T) {
+	cases := map[string]twiligo.NotificationLevel{
+		"default": twiligo.DefaultNotifications,
+		"muted":   twiligo.MutedNotifications,
+	}
+
+	for given, expected := range cases {
+		level := twiligo.ConvertLevelToNotificationLevel(given)
+
+		if level != expected {
+			t.Logf("Incorrect notification level returned, expected [%s], but received [%s]", expected, level)
+			t.Fail()
+		}
+	}
+}
+
+func TestWillConvertGivenModeStringToMatchingProxySessionMode(t *testing.T) {
+	cases := map[string]twiligo.ProxySessionMode{
+		"message-only":      twiligo.MessageOnlySession,
+		"voice-only":        twiligo.VoiceOnlySession,
+		"voice-and-message": twiligo.VoiceAndMessageSession,
+	}
+
+	for given, expected := range cases {
+		mode := twiligo.ConvertModeToProxySessionMode(given)
+
+		if mode != expected {
+			t.Logf("Incorrect proxy session mode returned, expected [%s], but received [%s]", expected, mode)
+			t.Fail()
+		}
+	}
+}
+
+func TestWillConvertGivenPermissionStringToMatchingChatPermission(t *testing.T) {
+	cases := map[string]twiligo.ChatPermission{
+		"createChannel":    twiligo.ChatCreateChannelPermission,
+		"editAnyUserInfo":  twiligo.ChatEditAnyUserInfoPermission,
+		"addMember":        twiligo.ChatAddMemberPermission,
+		"sendMessage":      twiligo.ChatSendMessagePermission,
+		"deleteAnyMessage": twiligo.ChatDeleteAnyMessagePermission,
+	}
+
+	for given, expected := range cases {
+		permission := twiligo.ConvertPermissionToChatPermission(given)
+
+		if permission != expected {
+			t.Logf("Incorrect chat permission returned, expected [%s], but received [%s]", expected, permission)
+			t.Fail()
+		}
+	}
+}
+
+func TestWillConvertGivenPermissionStringToMatchingConversationPermission(t *testing.T) {
+	cases := map[string]twiligo.ConversationPermission{
+		"createConversation":           twiligo.ConversationCreateConversationPermission,
+		"editAnyUserInfo":              twiligo.ConversationEditAnyUserInfoPermission,
+		"addParticipant":               twiligo.ConversationAddParticipantPermission,
+		"sendMessage":                  twiligo.ConversationSendMessagePermission,
+		"editAnyParticipantAttributes": twiligo.ConversationEditAnyParticipantAttributesPermission,
+	}
+
+	for given, expected := range cases {
+		permission := twiligo.ConvertPermissionToConversationPermission(given)
+
+		if permission != expected {
+			t.Logf("Incorrect conversation permission returned, expected [%s], but received [%s]", expected, permission)
+			t.Fail()
+		}
+	}
+}
+
+func TestWillConvertGivenTypeStringToMatchingAddressType(t *testing.T) {
+	cases := map[string]twiligo.AddressType{
+		"sms":       twiligo.SMSAddress,
+		"whatsapp":  twiligo.WhatsAppAddress,
+		"messenger": twiligo.MessengerAddress,
+		"gbm":       twiligo.GBMAddress,
+	}
+
+	for given, expected := range cases {
+		addressType := twiligo.ConvertTypeToAddressType(given)
+
+		if addressType != expected {
+			t.Logf("Incorrect address type returned, expected [%s], but received [%s]", expected, addressType)
+			t.Fail()
+		}
+	}
+}
+
+func TestWillConvertGivenTypeStringToMatchingAutoCreationType(t *testing.T) {
+	cases := map[string]twiligo.AutoCreationType{
+		"default": twiligo.DefaultAutoCreation,
+		"webhook": twiligo.WebhookAutoCreation,
+		"studio":  twiligo.StudioAutoCreation,
+	}
+
+	for given, expected := range cases {
+		autoCreationType := twiligo.ConvertTypeToAutoCreationType(given)
+
+		if autoCreationType != expected {
+			t.Logf("Incorrect auto creation type returned, expected [%s], but received [%s]", expected, autoCreationType)
+			t.Fail()
+		}
+	}
+}
+
+func TestWillConvertGivenTypeStringToMatchingChatChannelType(t *testing.T) {
+	cases := map[string]twiligo.ChatChannelType{
+		"public":  twiligo.PublicChatChannel,
+		"private": twiligo.PrivateChatChannel,
+	}
+
+	for given, expected := range cases {
+		channelType := twiligo.ConvertTypeToChatChannelType(given)
+
+		if channelType != expected {
+			t.Logf("Incorrect chat channel type returned, expected [%s], but received [%s]", expected, channelType)
+			t.Fail()
+		}
+	}
+}
+
+func TestWillConvertGivenTypeStringToMatchingChatCredentialType(t *testing.T) {
+	cases := map[string]twiligo.ChatCredentialType{
+		"apn": twiligo.APNChatCredential,
+		"fcm": twiligo.FCMChatCredential,
+		"gcm": twiligo.GCMChatCredential,
+	}
+
+	for given, expected := range cases {
+		credentialType := twiligo.ConvertTypeToChatCredentialType(given)
+
+		if credentialType != expected {
+			t.Logf("Incorrect chat credential type returned, expected [%s], but received [%s]", expected, credentialType)
+			t.Fail()
+		}
+	}
+}
+
+func TestWillConvertGivenTypeStringToMatchingChatRoleType(t *testing.T) {
+	cases := map[string]twiligo.ChatRoleType{
+		"channel":    twiligo.ChannelChatRole,
+		"deployment": twiligo.DeploymentChatRole,
+	}
+
+	for given, expected := range cases {
+		roleType := twiligo.ConvertTypeToChatRoleType(given)
+
+		if roleType != expected {
+			t.Logf("Incorrect chat role type returned, expected [%s], but received [%s]", expected, roleType)
+			t.Fail()
+		}
+	}
+}
+
+func TestWillConvertGivenTypeStringToMatchingConversationRoleType(t *testing.T) {
+	cases := map[string]twiligo.ConversationRoleType{
+		"conversation": twiligo.ConversationScopedRole,
+		"service":      twiligo.ConversationServiceScopedRole,
+	}
+
+	for given, expected := range cases {
+		roleType := twiligo.ConvertTypeToConversationRoleType(given)
+
+		if roleType != expected {
+			t.Logf("Incorrect conversation role type returned, expected [%s], but received [%s]", expected, roleType)
+			t.Fail()
+		}
+	}
+}
+
+func TestWillConvertGivenTypeStringToMatchingProxyInteractionType(t *testing.T) {
+	cases := map[string]twiligo.ProxyInteractionType{
+		"message": twiligo.MessageProxyInteraction,
+		"voice":   twiligo.VoiceProxyInteraction,
+		"unknown": twiligo.UnknownProxyInteraction,
+	}
+
+	for given, expected := range cases {
+		interactionType := twiligo.ConvertTypeToProxyInteractionType(given)
+
+		if interactionType != expected {
+			t.Logf("Incorrect proxy interaction type returned, expected [%s], but received [%s]", expected, interactionType)
+			t.Fail()
+		}
+	}
+}
+
+func TestWillConvertGivenStateStringToMatchingConversationState(t *testing.T) {
+	cases := map[string]twiligo.ConversationState{
+		"active":   twiligo.ActiveConversation,
+		"inactive": twiligo.InactiveConversation,
+		"closed":   twiligo.ClosedConversation,
+	}
+
+	for given, expected := range cases {
+		state := twiligo.ConvertStateToConversationState(given)
+
+		if state != expected {
+			t.Logf("Incorrect conversation state returned, expected [%s], but received [%s]", expected, state)
+			t.Fail()
+		}
+	}
+}
+
 func TestWillConvertGivenStatusStringToMatchingMessageStatus(t *testing.T) {
 	cases := map[string]twiligo.MessageStatus{
 		"accepted":    twiligo.Accepted,
@@ -47,6 +252,60 @@ func TestWillConvertGivenStatusStringToMatchingMessageStatus(t *testing.T) {
 	}
 }
 
+func TestWillConvertGivenStatusStringToMatchingProxyResourceStatus(t *testing.T) {
+	cases := map[string]twiligo.ProxyResourceStatus{
+		"accepted":         twiligo.AcceptedResource,
+		"answered":         twiligo.AnsweredResource,
+		"busy":             twiligo.BusyResource,
+		"canceled":         twiligo.CanceledResource,
+		"completed":        twiligo.CompletedResource,
+		"deleted":          twiligo.DeletedResource,
+		"delivered":        twiligo.DeliveredResource,
+		"delivery-unknown": twiligo.DeliveryUnknownResource,
+		"failed":           twiligo.FailedResource,
+		"in-progress":      twiligo.InProgressResource,
+		"initiated":        twiligo.InitiatedResource,
+		"no-answer":        twiligo.NoAnswerResource,
+		"queued":           twiligo.QueuedResource,
+		"received":         twiligo.ReceivedResource,
+		"receiving":        twiligo.ReceivingResource,
+		"ringing":          twiligo.RingingResource,
+		"scheduled":        twiligo.ScheduledResource,
+		"sending":          twiligo.SendingResource,
+		"sent":             twiligo.SentResource,
+		"undelivered":      twiligo.UndeliveredResource,
+		"unknown":          twiligo.UnknownResource,
+	}
+
+	for given, expected := range cases {
+		status := twiligo.ConvertStatusToProxyResourceStatus(given)
+
+		if status != expected {
+			t.Logf("Incorrect proxy resource status returned, expected [%s], but received [%s]", expected, status)
+			t.Fail()
+		}
+	}
+}
+
+func TestWillConvertGivenStatusStringToMatchingProxySessionStatus(t *testing.T) {
+	cases := map[string]twiligo.ProxySessionStatus{
+		"open":        twiligo.OpenSession,
+		"in-progress": twiligo.InProgressSession,
+		"closed":      twiligo.ClosedSession,
+		"failed":      twiligo.FailedSession,
+		"unknown":     twiligo.UnknownSession,
+	}
+
+	for given, expected := range cases {
+		status := twiligo.ConvertStatusToProxySessionStatus(given)
+
+		if status != expected {
+			t.Logf("Incorrect proxy session status returned, expected [%s], but received [%s]", expected, status)
+			t.Fail()
+		}
+	}
+}
+
 func TestWillConvertGivenNumberTypeAndCountryToMatchingPhoneNumberTypeToPrevent404ErrorsThroughTwilioAPI(t *testing.T) {
 	cases := map[string]map[twiligo.PhoneNumberType]twiligo.PhoneNumberType{
 		"CA": {twiligo.Mobile: twiligo.Local},
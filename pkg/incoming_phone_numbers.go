@@ -11,23 +11,23 @@ import (
 
 // CreateNewIncomingPhoneNumberOptions are all of the options that can be provided to a CreateNewIncomingPhoneNumber call.
 type CreateNewIncomingPhoneNumberOptions struct {
-	AddressSID           string `url:",omitempty"`
+	AddressSID           string `url:"AddressSid,omitempty"`
 	AreaCode             string `url:",omitempty"`
-	BundleSID            string `url:",omitempty"`
-	EmergencyAddressSID  string `url:",omitempty"`
+	BundleSID            string `url:"BundleSid,omitempty"`
+	EmergencyAddressSID  string `url:"EmergencyAddressSid,omitempty"`
 	EmergencyStatus      string `url:",omitempty"`
 	FriendlyName         string `url:",omitempty"`
-	IdentitySID          string `url:",omitempty"`
+	IdentitySID          string `url:"IdentitySid,omitempty"`
 	PhoneNumber          string `url:",omitempty"`
-	SmsApplicationSID    string `url:",omitempty"`
+	SmsApplicationSID    string `url:"SmsApplicationSid,omitempty"`
 	SmsFallbackMethod    string `url:",omitempty"`
 	SmsFallbackURL       string `url:"SmsFallbackUrl,omitempty"`
 	SmsMethod            string `url:",omitempty"`
 	SmsURL               string `url:"SmsUrl,omitempty"`
 	StatusCallback       string `url:",omitempty"`
 	StatusCallbackMethod string `url:",omitempty"`
-	TrunkSID             string `url:",omitempty"`
-	VoiceApplicationSID  string `url:",omitempty"`
+	TrunkSID             string `url:"TrunkSid,omitempty"`
+	VoiceApplicationSID  string `url:"VoiceApplicationSid,omitempty"`
 	VoiceFallbackMethod  string `url:",omitempty"`
 	VoiceFallbackURL     string `url:"VoiceFallbackUrl,omitempty"`
 	VoiceMethod          string `url:"VoiceMethod,omitempty"`
@@ -79,6 +79,46 @@ type IncomingPhoneNumber struct {
 	VoiceURL             *string                         `json:"voice_url"`
 }
 
+// IncomingPhoneNumbersResponse is the representation of the JSON response from Twilio when listing IncomingPhoneNumbers.
+type IncomingPhoneNumbersResponse struct {
+	Pagination
+	IncomingPhoneNumbers []*IncomingPhoneNumber `json:"incoming_phone_numbers"`
+}
+
+// ListIncomingPhoneNumbersOptions are all of the options that can be provided to a ListIncomingPhoneNumbers call. PhoneNumber and FriendlyName match partially, and Origin can be either twilio or hosted.
+type ListIncomingPhoneNumbersOptions struct {
+	PageOptions
+	Beta         *bool  `url:",omitempty"`
+	FriendlyName string `url:",omitempty"`
+	Origin       string `url:",omitempty"`
+	PhoneNumber  string `url:",omitempty"`
+}
+
+// UpdateIncomingPhoneNumberOptions are all of the options that can be provided to an UpdateIncomingPhoneNumber call.
+type UpdateIncomingPhoneNumberOptions struct {
+	AddressSID           string `url:"AddressSid,omitempty"`
+	BundleSID            string `url:"BundleSid,omitempty"`
+	EmergencyAddressSID  string `url:"EmergencyAddressSid,omitempty"`
+	EmergencyStatus      string `url:",omitempty"`
+	FriendlyName         string `url:",omitempty"`
+	IdentitySID          string `url:"IdentitySid,omitempty"`
+	SmsApplicationSID    string `url:"SmsApplicationSid,omitempty"`
+	SmsFallbackMethod    string `url:",omitempty"`
+	SmsFallbackURL       string `url:"SmsFallbackUrl,omitempty"`
+	SmsMethod            string `url:",omitempty"`
+	SmsURL               string `url:"SmsUrl,omitempty"`
+	StatusCallback       string `url:",omitempty"`
+	StatusCallbackMethod string `url:",omitempty"`
+	TrunkSID             string `url:"TrunkSid,omitempty"`
+	VoiceApplicationSID  string `url:"VoiceApplicationSid,omitempty"`
+	VoiceCallerIDLookup  *bool  `url:"VoiceCallerIdLookup,omitempty"`
+	VoiceFallbackMethod  string `url:",omitempty"`
+	VoiceFallbackURL     string `url:"VoiceFallbackUrl,omitempty"`
+	VoiceMethod          string `url:"VoiceMethod,omitempty"`
+	VoiceReceiveMode     string `url:",omitempty"`
+	VoiceURL             string `url:"VoiceUrl,omitempty"`
+}
+
 // CreateNewIncomingPhoneNumber purchases a new phone number in Twilio.
 func (twilio *Twilio) CreateNewIncomingPhoneNumber(options CreateNewIncomingPhoneNumberOptions) (*IncomingPhoneNumber, error) {
 	params, err := query.Values(options)
@@ -119,12 +159,160 @@ func (twilio *Twilio) CreateNewIncomingPhoneNumber(options CreateNewIncomingPhon
 	return response, nil
 }
 
+// FetchIncomingPhoneNumber retrieves the IncomingPhoneNumber matching the given identifier from Twilio.
+func (twilio *Twilio) FetchIncomingPhoneNumber(phoneNumberSID string) (*IncomingPhoneNumber, error) {
+	res, err := twilio.get(twilio.url("IncomingPhoneNumbers/"+phoneNumberSID+".json"), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(IncomingPhoneNumber)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// ListIncomingPhoneNumbers retrieves a single page of the phone numbers purchased by the current account from Twilio.
+func (twilio *Twilio) ListIncomingPhoneNumbers(options ListIncomingPhoneNumbersOptions) (*IncomingPhoneNumbersResponse, error) {
+	return twilio.listIncomingPhoneNumbers("IncomingPhoneNumbers.json", options)
+}
+
+// ListIncomingPhoneNumbersByType retrieves a single page of the local, toll-free or mobile phone numbers purchased by the current account from Twilio.
+func (twilio *Twilio) ListIncomingPhoneNumbersByType(number PhoneNumberType, options ListIncomingPhoneNumbersOptions) (*IncomingPhoneNumbersResponse, error) {
+	return twilio.listIncomingPhoneNumbers("IncomingPhoneNumbers/"+number.String()+".json", options)
+}
+
+// UpdateIncomingPhoneNumber will update an existing IncomingPhoneNumber in Twilio based on the provided identifier and options.
+func (twilio *Twilio) UpdateIncomingPhoneNumber(phoneNumberSID string, options UpdateIncomingPhoneNumberOptions) (*IncomingPhoneNumber, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.url("IncomingPhoneNumbers/"+phoneNumberSID+".json"), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(IncomingPhoneNumber)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// TransferIncomingPhoneNumber moves the IncomingPhoneNumber matching the given identifier to the account matching the given identifier, removing any webhook, application or trunk not replaced through the given options. Twilio rejects the transfer when a given address, bundle or identity does not belong to the target account.
+func (twilio *Twilio) TransferIncomingPhoneNumber(phoneNumberSID, accountSID string, options UpdateIncomingPhoneNumberOptions) (*IncomingPhoneNumber, error) {
+	if accountSID == "" {
+		return nil, errors.New("Missing required parameter AccountSid")
+	}
+
+	number, err := twilio.FetchIncomingPhoneNumber(phoneNumberSID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if number.AddressRequirements != "" && number.AddressRequirements != "none" && options.AddressSID == "" {
+		return nil, errors.New("Missing required parameter AddressSid, phone number " + number.PhoneNumber + " requires a " + number.AddressRequirements + " address in the target account")
+	}
+
+	if number.BundleSID != nil && *number.BundleSID != "" && options.BundleSID == "" {
+		return nil, errors.New("Missing required parameter BundleSid, phone number " + number.PhoneNumber + " requires a regulatory bundle in the target account")
+	}
+
+	if number.EmergencyAddressSID != nil && *number.EmergencyAddressSID != "" && options.EmergencyAddressSID == "" {
+		return nil, errors.New("Missing required parameter EmergencyAddressSid, phone number " + number.PhoneNumber + " requires an emergency address in the target account")
+	}
+
+	if number.IdentitySID != nil && *number.IdentitySID != "" && options.IdentitySID == "" {
+		return nil, errors.New("Missing required parameter IdentitySid, phone number " + number.PhoneNumber + " requires an identity in the target account")
+	}
+
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	params.Set("AccountSid", accountSID)
+
+	configured := map[string]*string{
+		"SmsApplicationSid":   number.SmsApplicationSID,
+		"SmsFallbackUrl":      number.SmsFallbackURL,
+		"SmsUrl":              number.SmsURL,
+		"StatusCallback":      number.StatusCallback,
+		"TrunkSid":            number.TrunkSID,
+		"VoiceApplicationSid": number.VoiceApplicationSID,
+		"VoiceFallbackUrl":    number.VoiceFallbackURL,
+		"VoiceUrl":            number.VoiceURL,
+	}
+
+	for name, value := range configured {
+		if value != nil && *value != "" && params.Get(name) == "" {
+			params.Set(name, "")
+		}
+	}
+
+	res, err := twilio.post(twilio.url("IncomingPhoneNumbers/"+phoneNumberSID+".json"), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(IncomingPhoneNumber)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
 // DeleteIncomingPhoneNumber will release an existing IncomingPhoneNumber from Twilio.
 func (twilio *Twilio) DeleteIncomingPhoneNumber(phoneNumberSID string) error {
 	res, err := twilio.delete(twilio.url("IncomingPhoneNumbers/" + phoneNumberSID + ".json"))
 
 	if err != nil {
-		return nil
+		return err
 	}
 
 	defer res.Body.Close()
@@ -141,3 +329,35 @@ func (twilio *Twilio) DeleteIncomingPhoneNumber(phoneNumberSID string) error {
 
 	return nil
 }
+
+func (twilio *Twilio) listIncomingPhoneNumbers(resource string, options ListIncomingPhoneNumbersOptions) (*IncomingPhoneNumbersResponse, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.get(twilio.url(resource), &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(IncomingPhoneNumbersResponse)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
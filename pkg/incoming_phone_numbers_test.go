@@ -2,6 +2,7 @@ package twiligo_test
 
 import (
 	"bytes"
+	"errors"
 	"io/ioutil"
 	"net/http"
 	"net/url"
@@ -142,6 +143,58 @@ func TestWillIncludeProperRequestBodyParametersWhenMakingRequestToCreateNewIncom
 	})
 }
 
+func TestWillSendSidParameterNamesWhenMakingRequestToCreateNewIncomingNumber(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		expected := map[string]string{
+			"AddressSid":          "ADXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"BundleSid":           "BUXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"EmergencyAddressSid": "ADYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY",
+			"IdentitySid":         "RIXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"PhoneNumber":         "+15555555555",
+			"SmsApplicationSid":   "APXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"TrunkSid":            "TKXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"VoiceApplicationSid": "APYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY",
+		}
+
+		if len(params) != len(expected) {
+			t.Logf("Incorrect request parameters supplied, expecting [%v], but received [%v]", expected, params)
+			t.Fail()
+		}
+
+		for key, value := range expected {
+			if params.Get(key) != value {
+				t.Logf("Incorrect request parameter supplied for [%s], expecting [%s], but received [%s]", key, value, params.Get(key))
+				t.Fail()
+			}
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(createdIncomingPhoneNumberResponse)),
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+		}
+	})
+
+	_, err := twilio.CreateNewIncomingPhoneNumber(twiligo.CreateNewIncomingPhoneNumberOptions{
+		AddressSID:          "ADXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+		BundleSID:           "BUXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+		EmergencyAddressSID: "ADYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY",
+		IdentitySID:         "RIXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+		PhoneNumber:         "+15555555555",
+		SmsApplicationSID:   "APXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+		TrunkSID:            "TKXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+		VoiceApplicationSID: "APYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY",
+	})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+}
+
 func TestWillHandleErrorResponsesWhenMakingRequestToCreateNewIncomingNumber(t *testing.T) {
 	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
 		return &http.Response{
@@ -238,3 +291,390 @@ func TestWillHandleErrorResponsesWhenMakingRequestToDeleteExistingIncomingNumber
 		t.Fail()
 	}
 }
+
+func TestWillReturnTransportErrorsWhenMakingRequestToDeleteExistingIncomingNumber(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		return nil
+	})
+
+	err := twilio.DeleteIncomingPhoneNumber("PN123456")
+
+	if err == nil {
+		t.Log("Error was not returned, was expecting the transport error")
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToListIncomingPhoneNumbersSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		if strings.HasSuffix(req.URL.Path, "/IncomingPhoneNumbers.json") == false {
+			t.Logf("Incorrect URL supplied, expecting URL to end with [%s], but received [%s]", "/IncomingPhoneNumbers.json", req.URL.Path)
+			t.Fail()
+		}
+
+		query := req.URL.Query()
+
+		if query.Get("PhoneNumber") != "555" || query.Get("Beta") != "false" || query.Get("PageToken") != "PAPNXXXXXXXXXXXXXXXX" {
+			t.Logf("Incorrect query parameters supplied, received [%v]", query)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body: ioutil.NopCloser(bytes.NewBufferString(`{
+				"incoming_phone_numbers": [` + createdIncomingPhoneNumberResponse + `],
+				"page": 1,
+				"page_size": 50,
+				"start": 50,
+				"end": 50,
+				"uri": "/2010-04-01/Accounts/ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/IncomingPhoneNumbers.json?PageSize=50&Page=1",
+				"first_page_uri": "/2010-04-01/Accounts/ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/IncomingPhoneNumbers.json?PageSize=50&Page=0",
+				"previous_page_uri": null,
+				"next_page_uri": null
+			}`)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	beta := false
+
+	response, err := twilio.ListIncomingPhoneNumbers(twiligo.ListIncomingPhoneNumbersOptions{
+		PageOptions: twiligo.PageOptions{Page: 1, PageToken: "PAPNXXXXXXXXXXXXXXXX"},
+		Beta:        &beta,
+		PhoneNumber: "555",
+	})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if len(response.IncomingPhoneNumbers) != 1 || response.Page != 1 || response.NextPage() != nil {
+		t.Logf("Incorrect phone numbers decoded, received [%v]", response)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToListIncomingPhoneNumbersByTypeSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		if strings.HasSuffix(req.URL.Path, "/IncomingPhoneNumbers/TollFree.json") == false {
+			t.Logf("Incorrect URL supplied, expecting URL to end with [%s], but received [%s]", "/IncomingPhoneNumbers/TollFree.json", req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"incoming_phone_numbers": [], "page": 0, "page_size": 50, "next_page_uri": null}`)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.ListIncomingPhoneNumbersByType(twiligo.TollFree, twiligo.ListIncomingPhoneNumbersOptions{})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if len(response.IncomingPhoneNumbers) != 0 {
+		t.Logf("Incorrect phone numbers decoded, received [%v]", response.IncomingPhoneNumbers)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToFetchIncomingPhoneNumberSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		if req.Method != http.MethodGet || strings.HasSuffix(req.URL.Path, "/IncomingPhoneNumbers/PNXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX.json") == false {
+			t.Logf("Incorrect request supplied, received [%s %s]", req.Method, req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(createdIncomingPhoneNumberResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.FetchIncomingPhoneNumber("PNXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX")
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if response.PhoneNumber != "+15555555555" {
+		t.Logf("Incorrect phone number decoded, expecting [%s], but received [%s]", "+15555555555", response.PhoneNumber)
+		t.Fail()
+	}
+}
+
+func TestWillIncludeProperRequestBodyParametersWhenMakingRequestToUpdateIncomingPhoneNumber(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		if params.Get("SmsUrl") != "https://example.com/sms" || params.Get("VoiceUrl") != "https://example.com/voice" || params.Get("VoiceCallerIdLookup") != "true" {
+			t.Logf("Incorrect request parameters supplied, received [%v]", params)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(createdIncomingPhoneNumberResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	lookup := true
+
+	_, err := twilio.UpdateIncomingPhoneNumber("PNXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.UpdateIncomingPhoneNumberOptions{
+		SmsURL:              "https://example.com/sms",
+		VoiceCallerIDLookup: &lookup,
+		VoiceURL:            "https://example.com/voice",
+	})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+}
+
+func TestWillSendSidParameterNamesWhenMakingRequestToUpdateIncomingPhoneNumber(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		expected := map[string]string{
+			"AddressSid":          "ADXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"BundleSid":           "BUXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"EmergencyAddressSid": "ADYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY",
+			"IdentitySid":         "RIXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"SmsApplicationSid":   "APXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"TrunkSid":            "TKXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+			"VoiceApplicationSid": "APYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY",
+		}
+
+		for key, value := range expected {
+			if params.Get(key) != value {
+				t.Logf("Incorrect request parameter supplied for [%s], expecting [%s], but received [%s]", key, value, params.Get(key))
+				t.Fail()
+			}
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(createdIncomingPhoneNumberResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	_, err := twilio.UpdateIncomingPhoneNumber("PNXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.UpdateIncomingPhoneNumberOptions{
+		AddressSID:          "ADXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+		BundleSID:           "BUXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+		EmergencyAddressSID: "ADYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY",
+		IdentitySID:         "RIXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+		SmsApplicationSID:   "APXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+		TrunkSID:            "TKXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+		VoiceApplicationSID: "APYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY",
+	})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+}
+
+const addressNotOwnedByTargetAccountResponse = `{
+	"code": 21631,
+	"message": "The EmergencyAddressSid ADXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX does not belong to account ACYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY",
+	"more_info": "https://www.twilio.com/docs/errors/21631",
+	"status": 400
+}`
+
+func TestWillNotTransferIncomingPhoneNumberWithoutBundleForTargetAccount(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		if req.Method != http.MethodGet {
+			t.Logf("Request was incorrectly made, was not expecting the following request: %v", req)
+			t.FailNow()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(createdIncomingPhoneNumberResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.TransferIncomingPhoneNumber("PNXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "ACYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY", twiligo.UpdateIncomingPhoneNumberOptions{})
+
+	if response != nil {
+		t.Logf("Response was incorrectly returned, was not expecting the following response: %v", response)
+		t.Fail()
+	}
+
+	if err == nil || strings.HasPrefix(err.Error(), "Missing required parameter BundleSid") == false {
+		t.Logf("Incorrect error returned, expected missing BundleSid, but received [%v]", err)
+		t.Fail()
+	}
+}
+
+func TestWillNotTransferIncomingPhoneNumberWithoutEmergencyAddressForTargetAccount(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		if req.Method != http.MethodGet {
+			t.Logf("Request was incorrectly made, was not expecting the following request: %v", req)
+			t.FailNow()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(createdIncomingPhoneNumberResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.TransferIncomingPhoneNumber("PNXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "ACYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY", twiligo.UpdateIncomingPhoneNumberOptions{
+		BundleSID: "BUYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY",
+	})
+
+	if response != nil {
+		t.Logf("Response was incorrectly returned, was not expecting the following response: %v", response)
+		t.Fail()
+	}
+
+	if err == nil || strings.HasPrefix(err.Error(), "Missing required parameter EmergencyAddressSid") == false {
+		t.Logf("Incorrect error returned, expected missing EmergencyAddressSid, but received [%v]", err)
+		t.Fail()
+	}
+}
+
+func TestWillNotTransferIncomingPhoneNumberWithoutIdentityForTargetAccount(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		if req.Method != http.MethodGet {
+			t.Logf("Request was incorrectly made, was not expecting the following request: %v", req)
+			t.FailNow()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(createdIncomingPhoneNumberResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.TransferIncomingPhoneNumber("PNXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "ACYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY", twiligo.UpdateIncomingPhoneNumberOptions{
+		BundleSID:           "BUYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY",
+		EmergencyAddressSID: "ADYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY",
+	})
+
+	if response != nil {
+		t.Logf("Response was incorrectly returned, was not expecting the following response: %v", response)
+		t.Fail()
+	}
+
+	if err == nil || strings.HasPrefix(err.Error(), "Missing required parameter IdentitySid") == false {
+		t.Logf("Incorrect error returned, expected missing IdentitySid, but received [%v]", err)
+		t.Fail()
+	}
+}
+
+func TestWillTransferIncomingPhoneNumberAndRewriteWebhookConfiguration(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		if req.Method == http.MethodGet {
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(strings.NewReplacer(`"voice_application_sid": ""`, `"voice_application_sid": "APXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"`, `"sms_url": ""`, `"sms_url": "https://example.com/sms"`, `"voice_url": null`, `"voice_url": "https://example.com/voice"`).Replace(createdIncomingPhoneNumberResponse))),
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+			}
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		expected := map[string]string{
+			"AccountSid":          "ACYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY",
+			"BundleSid":           "BUYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY",
+			"EmergencyAddressSid": "ADYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY",
+			"IdentitySid":         "RIYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY",
+			"VoiceUrl":            "https://subaccount.example.com/voice",
+		}
+
+		for key, value := range expected {
+			if params.Get(key) != value {
+				t.Logf("Incorrect request parameter supplied for [%s], expecting [%s], but received [%s]", key, value, params.Get(key))
+				t.Fail()
+			}
+		}
+
+		for _, key := range []string{"SmsUrl", "VoiceApplicationSid"} {
+			if value, ok := params[key]; ok == false || value[0] != "" {
+				t.Logf("Request parameter %s was not removed, received [%v]", key, params)
+				t.Fail()
+			}
+		}
+
+		if _, ok := params["SmsApplicationSid"]; ok {
+			t.Log("Request parameter SmsApplicationSid was incorrectly supplied")
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(strings.Replace(createdIncomingPhoneNumberResponse, "ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "ACYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY", -1))),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.TransferIncomingPhoneNumber("PNXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "ACYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY", twiligo.UpdateIncomingPhoneNumberOptions{
+		BundleSID:           "BUYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY",
+		EmergencyAddressSID: "ADYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY",
+		IdentitySID:         "RIYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY",
+		VoiceURL:            "https://subaccount.example.com/voice",
+	})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if response.AccountSID != "ACYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY" {
+		t.Logf("Incorrect account decoded, expecting [%s], but received [%s]", "ACYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY", response.AccountSID)
+		t.Fail()
+	}
+}
+
+func TestWillReturnErrorWhenTargetAccountDoesNotOwnTransferredIncomingPhoneNumberConfiguration(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		if req.Method == http.MethodGet {
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(createdIncomingPhoneNumberResponse)),
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+			}
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(addressNotOwnedByTargetAccountResponse)),
+			StatusCode: http.StatusBadRequest,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.TransferIncomingPhoneNumber("PNXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "ACYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY", twiligo.UpdateIncomingPhoneNumberOptions{
+		BundleSID:           "BUYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY",
+		EmergencyAddressSID: "ADXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+		IdentitySID:         "RIYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY",
+	})
+
+	if response != nil {
+		t.Logf("Response was incorrectly returned, was not expecting the following response: %v", response)
+		t.Fail()
+	}
+
+	var exception *twiligo.Exception
+
+	if errors.As(err, &exception) == false || exception.Code != 21631 {
+		t.Logf("Incorrect error returned, expected the exception returned by Twilio, but received [%v]", err)
+		t.Fail()
+	}
+}
@@ -0,0 +1,76 @@
+package twiligo
+
+import (
+	"net/url"
+	"strconv"
+)
+
+// Meta represents the paging information that is returned alongside any listing from the versioned Twilio REST APIs (Chat, Conversations, Proxy, etc.).
+type Meta struct {
+	Page            int     `json:"page"`
+	PageSize        int     `json:"page_size"`
+	FirstPageURL    string  `json:"first_page_url"`
+	PreviousPageURL *string `json:"previous_page_url"`
+	URL             string  `json:"url"`
+	NextPageURL     *string `json:"next_page_url"`
+	Key             string  `json:"key"`
+}
+
+// PageOptions are all of the paging options that can be provided to any call that lists resources from Twilio.
+type PageOptions struct {
+	Page      int    `url:",omitempty" json:"page,omitempty"`
+	PageSize  int    `url:",omitempty" json:"page_size,omitempty"`
+	PageToken string `url:",omitempty" json:"page_token,omitempty"`
+}
+
+// Pagination represents the paging information that is returned at the top level of any listing from the 2010-04-01 Twilio REST API (IncomingPhoneNumbers, etc.).
+type Pagination struct {
+	Page            int     `json:"page"`
+	PageSize        int     `json:"page_size"`
+	Start           int     `json:"start"`
+	End             int     `json:"end"`
+	URI             string  `json:"uri"`
+	FirstPageURI    string  `json:"first_page_uri"`
+	PreviousPageURI *string `json:"previous_page_uri"`
+	NextPageURI     *string `json:"next_page_uri"`
+}
+
+// NextPage returns the PageOptions necessary to request the page following the current one, or nil when the current page is the last page.
+func (meta Meta) NextPage() *PageOptions {
+	return nextPage(meta.Page, meta.PageSize, meta.NextPageURL)
+}
+
+// NextPage returns the PageOptions necessary to request the page following the current one, or nil when the current page is the last page.
+func (pagination Pagination) NextPage() *PageOptions {
+	return nextPage(pagination.Page, pagination.PageSize, pagination.NextPageURI)
+}
+
+func nextPage(page, pageSize int, nextPageURL *string) *PageOptions {
+	if nextPageURL == nil || *nextPageURL == "" {
+		return nil
+	}
+
+	next, err := url.Parse(*nextPageURL)
+
+	if err != nil {
+		return nil
+	}
+
+	params := next.Query()
+
+	options := &PageOptions{
+		Page:      page + 1,
+		PageSize:  pageSize,
+		PageToken: params.Get("PageToken"),
+	}
+
+	if page, err := strconv.Atoi(params.Get("Page")); err == nil {
+		options.Page = page
+	}
+
+	if size, err := strconv.Atoi(params.Get("PageSize")); err == nil {
+		options.PageSize = size
+	}
+
+	return options
+}
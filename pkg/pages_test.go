@@ -0,0 +1,75 @@
+package twiligo_test
+
+import (
+	"testing"
+
+	twiligo "github.com/craigpaul/twiligo/pkg"
+)
+
+func TestWillBuildNextPageOptionsFromNextPageURL(t *testing.T) {
+	next := "https://conversations.twilio.com/v1/Services?PageSize=20&Page=3&PageToken=PTXXXXXXXXXXXXXXXX"
+
+	meta := twiligo.Meta{
+		Page:        2,
+		PageSize:    20,
+		NextPageURL: &next,
+	}
+
+	options := meta.NextPage()
+
+	if options == nil {
+		t.Log("Did not receive the expected page options")
+		t.FailNow()
+	}
+
+	if options.Page != 3 {
+		t.Logf("Incorrect page returned, expecting [%d], but received [%d]", 3, options.Page)
+		t.Fail()
+	}
+
+	if options.PageSize != 20 {
+		t.Logf("Incorrect page size returned, expecting [%d], but received [%d]", 20, options.PageSize)
+		t.Fail()
+	}
+
+	if options.PageToken != "PTXXXXXXXXXXXXXXXX" {
+		t.Logf("Incorrect page token returned, expecting [%s], but received [%s]", "PTXXXXXXXXXXXXXXXX", options.PageToken)
+		t.Fail()
+	}
+}
+
+func TestWillNotBuildNextPageOptionsWhenOnTheLastPage(t *testing.T) {
+	meta := twiligo.Meta{
+		Page:     0,
+		PageSize: 50,
+	}
+
+	options := meta.NextPage()
+
+	if options != nil {
+		t.Logf("Page options were incorrectly returned, was not expecting the following options: %v", options)
+		t.Fail()
+	}
+}
+
+func TestWillBuildNextPageOptionsFromRelativeNextPageURI(t *testing.T) {
+	next := "/2010-04-01/Accounts/ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/IncomingPhoneNumbers.json?PageSize=50&Page=1&PageToken=PAPNXXXXXXXXXXXXXXXX"
+
+	pagination := twiligo.Pagination{
+		Page:        0,
+		PageSize:    50,
+		NextPageURI: &next,
+	}
+
+	options := pagination.NextPage()
+
+	if options == nil {
+		t.Log("Did not receive the expected page options")
+		t.FailNow()
+	}
+
+	if options.Page != 1 || options.PageSize != 50 || options.PageToken != "PAPNXXXXXXXXXXXXXXXX" {
+		t.Logf("Incorrect page options returned, received [%v]", options)
+		t.Fail()
+	}
+}
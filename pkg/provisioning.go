@@ -0,0 +1,144 @@
+package twiligo
+
+import (
+	"errors"
+	"strconv"
+)
+
+// PhoneNumberUnavailableErrorCode is the error code returned by Twilio when the phone number being purchased is no longer available.
+const PhoneNumberUnavailableErrorCode = 21422
+
+// ProvisionPhoneNumberOptions are all of the options that can be provided to a ProvisionPhoneNumber call.
+type ProvisionPhoneNumberOptions struct {
+	// Search narrows down the available phone numbers that are considered as candidates.
+	Search GetAvailablePhoneNumberOptions
+	// Purchase holds any options, such as an AddressSID or BundleSID, needed to buy a candidate. AreaCode and PhoneNumber are ignored as each candidate is bought by its own number.
+	Purchase CreateNewIncomingPhoneNumberOptions
+	// Configure holds the SMS and voice webhook configuration applied to the phone number once it has been bought. Nothing is configured when it is left empty.
+	Configure UpdateIncomingPhoneNumberOptions
+	// ProxyServiceSID is the proxy service the phone number is attached to once it has been configured. The phone number is not attached to any proxy service when it is left empty.
+	ProxyServiceSID string
+	// IsReserved is passed along when attaching the phone number to the proxy service.
+	IsReserved *bool
+	// MaxCandidates limits how many of the available phone numbers are tried before giving up. Every available phone number is tried when it is zero.
+	MaxCandidates int
+}
+
+// ProvisioningAttempt records what happened to a single candidate phone number during a ProvisionPhoneNumber call. FailedStep is one of purchase, configure or proxy, and is empty when the attempt succeeded.
+type ProvisioningAttempt struct {
+	PhoneNumber  string
+	SID          string
+	FailedStep   string
+	Error        error
+	Released     bool
+	ReleaseError error
+}
+
+// ProvisioningReport describes the outcome of a ProvisionPhoneNumber call: every candidate that was offered by Twilio, every attempt that was made to provision one of them and, when successful, the resulting phone number.
+type ProvisioningReport struct {
+	Candidates          []string
+	Attempts            []*ProvisioningAttempt
+	IncomingPhoneNumber *IncomingPhoneNumber
+	ProxyPhoneNumber    *ProxyPhoneNumber
+}
+
+// ProvisionPhoneNumber buys, configures and attaches to a proxy service the first available phone number of the given country and type, releasing it again when a later step fails.
+func (twilio *Twilio) ProvisionPhoneNumber(country string, number PhoneNumberType, options ProvisionPhoneNumberOptions) (*ProvisioningReport, error) {
+	report := &ProvisioningReport{
+		Candidates: []string{},
+		Attempts:   []*ProvisioningAttempt{},
+	}
+
+	candidates, err := twilio.GetAvailablePhoneNumbers(country, number, options.Search)
+
+	if err != nil {
+		return report, err
+	}
+
+	if options.MaxCandidates > 0 && len(candidates) > options.MaxCandidates {
+		candidates = candidates[:options.MaxCandidates]
+	}
+
+	for _, candidate := range candidates {
+		report.Candidates = append(report.Candidates, candidate.PhoneNumber)
+	}
+
+	if len(candidates) == 0 {
+		return report, errors.New("No available phone numbers matched the given search options")
+	}
+
+	for _, candidate := range candidates {
+		attempt := &ProvisioningAttempt{PhoneNumber: candidate.PhoneNumber}
+
+		report.Attempts = append(report.Attempts, attempt)
+
+		purchase := options.Purchase
+
+		purchase.AreaCode = ""
+		purchase.PhoneNumber = candidate.PhoneNumber
+
+		incoming, err := twilio.CreateNewIncomingPhoneNumber(purchase)
+
+		if err != nil {
+			attempt.FailedStep = "purchase"
+			attempt.Error = err
+
+			var exception *Exception
+
+			if errors.As(err, &exception) && exception.Code == PhoneNumberUnavailableErrorCode {
+				continue
+			}
+
+			return report, err
+		}
+
+		attempt.SID = incoming.SID
+
+		err = twilio.configureProvisionedPhoneNumber(incoming, attempt, options, report)
+
+		if err != nil {
+			attempt.Error = err
+			attempt.ReleaseError = twilio.DeleteIncomingPhoneNumber(incoming.SID)
+			attempt.Released = attempt.ReleaseError == nil
+
+			return report, err
+		}
+
+		return report, nil
+	}
+
+	return report, errors.New("Unable to purchase any of the " + strconv.Itoa(len(candidates)) + " available phone numbers")
+}
+
+func (twilio *Twilio) configureProvisionedPhoneNumber(incoming *IncomingPhoneNumber, attempt *ProvisioningAttempt, options ProvisionPhoneNumberOptions, report *ProvisioningReport) error {
+	if options.Configure != (UpdateIncomingPhoneNumberOptions{}) {
+		configured, err := twilio.UpdateIncomingPhoneNumber(incoming.SID, options.Configure)
+
+		if err != nil {
+			attempt.FailedStep = "configure"
+
+			return err
+		}
+
+		incoming = configured
+	}
+
+	if options.ProxyServiceSID != "" {
+		proxy, err := twilio.AddPhoneNumberToProxyService(options.ProxyServiceSID, AddPhoneNumberToProxyServiceOptions{
+			SID:        incoming.SID,
+			IsReserved: options.IsReserved,
+		})
+
+		if err != nil {
+			attempt.FailedStep = "proxy"
+
+			return err
+		}
+
+		report.ProxyPhoneNumber = proxy
+	}
+
+	report.IncomingPhoneNumber = incoming
+
+	return nil
+}
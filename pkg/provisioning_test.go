@@ -0,0 +1,243 @@
+package twiligo_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	twiligo "github.com/craigpaul/twiligo/pkg"
+)
+
+const twoAvailablePhoneNumbersResponse = `{
+	"available_phone_numbers": [
+		{"phone_number": "+15555555555", "address_requirements": "none", "capabilities": {"mms": false, "sms": true, "voice": true}},
+		{"phone_number": "+15555555556", "address_requirements": "none", "capabilities": {"mms": false, "sms": true, "voice": true}}
+	]
+}`
+
+const phoneNumberUnavailableResponse = `{
+	"code": 21422,
+	"message": "PhoneNumber requested is not available",
+	"more_info": "https://www.twilio.com/docs/errors/21422",
+	"status": 400
+}`
+
+const phoneNumberRequiresAddressResponse = `{
+	"code": 21631,
+	"message": "Phone Number Requires an Address but the 'AddressSid' parameter was empty.",
+	"more_info": "https://www.twilio.com/docs/errors/21631",
+	"status": 400
+}`
+
+func TestWillFallBackToNextCandidateWhenProvisioningPhoneNumber(t *testing.T) {
+	requests := []string{}
+
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		requests = append(requests, req.Method+" "+req.URL.Path[strings.LastIndex(req.URL.Path, "/")+1:])
+
+		if req.Method == http.MethodGet {
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(twoAvailablePhoneNumbersResponse)),
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+			}
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		switch {
+		case strings.HasSuffix(req.URL.Path, "/IncomingPhoneNumbers.json") && params.Get("PhoneNumber") == "+15555555555":
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(phoneNumberUnavailableResponse)),
+				StatusCode: http.StatusBadRequest,
+				Header:     make(http.Header),
+			}
+		case strings.HasSuffix(req.URL.Path, "/IncomingPhoneNumbers.json"):
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(strings.Replace(createdIncomingPhoneNumberResponse, "+15555555555", "+15555555556", 1))),
+				StatusCode: http.StatusCreated,
+				Header:     make(http.Header),
+			}
+		case strings.HasSuffix(req.URL.Path, ".json"):
+			if params.Get("SmsUrl") != "https://example.com/sms" {
+				t.Logf("Incorrect request parameter supplied, expecting [%s], but received [%s]", "https://example.com/sms", params.Get("SmsUrl"))
+				t.Fail()
+			}
+
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(strings.Replace(createdIncomingPhoneNumberResponse, "+15555555555", "+15555555556", 1))),
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+			}
+		}
+
+		if params.Get("Sid") != "PNXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX" {
+			t.Logf("Incorrect request parameter supplied, expecting [%s], but received [%s]", "PNXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", params.Get("Sid"))
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(proxyPhoneNumberResponse)),
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+		}
+	})
+
+	report, err := twilio.ProvisionPhoneNumber("CA", twiligo.Local, twiligo.ProvisionPhoneNumberOptions{
+		Configure: twiligo.UpdateIncomingPhoneNumberOptions{
+			SmsURL: "https://example.com/sms",
+		},
+		ProxyServiceSID: "KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if len(report.Attempts) != 2 || report.Attempts[0].FailedStep != "purchase" || report.Attempts[1].FailedStep != "" {
+		t.Logf("Incorrect attempts reported, received [%v]", report.Attempts)
+		t.Fail()
+	}
+
+	if report.IncomingPhoneNumber.PhoneNumber != "+15555555556" || report.ProxyPhoneNumber == nil {
+		t.Logf("Incorrect phone number reported, received [%v]", report)
+		t.Fail()
+	}
+
+	expected := []string{
+		"GET Local.json",
+		"POST IncomingPhoneNumbers.json",
+		"POST IncomingPhoneNumbers.json",
+		"POST PNXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX.json",
+		"POST PhoneNumbers",
+	}
+
+	if strings.Join(requests, ",") != strings.Join(expected, ",") {
+		t.Logf("Incorrect requests made, expected [%v], but received [%v]", expected, requests)
+		t.Fail()
+	}
+}
+
+func TestWillStopProvisioningWhenPurchaseFailsForAnotherReason(t *testing.T) {
+	purchases := 0
+
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		if req.Method == http.MethodGet {
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(twoAvailablePhoneNumbersResponse)),
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+			}
+		}
+
+		purchases++
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(phoneNumberRequiresAddressResponse)),
+			StatusCode: http.StatusBadRequest,
+			Header:     make(http.Header),
+		}
+	})
+
+	report, err := twilio.ProvisionPhoneNumber("CA", twiligo.Local, twiligo.ProvisionPhoneNumberOptions{})
+
+	expected := "Phone Number Requires an Address but the 'AddressSid' parameter was empty."
+
+	if err == nil || err.Error() != expected {
+		t.Logf("Incorrect error returned, expected [%s], but received [%v]", expected, err)
+		t.Fail()
+	}
+
+	if purchases != 1 {
+		t.Logf("Incorrect number of purchases made, expected [%d], but received [%d]", 1, purchases)
+		t.Fail()
+	}
+
+	if len(report.Attempts) != 1 || report.Attempts[0].FailedStep != "purchase" || report.Attempts[0].Error != err {
+		t.Logf("Incorrect attempts reported, received [%v]", report.Attempts)
+		t.Fail()
+	}
+}
+
+func TestWillReleasePurchasedPhoneNumberWhenProvisioningFailsAfterPurchase(t *testing.T) {
+	released := false
+
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		switch {
+		case req.Method == http.MethodGet:
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(twoAvailablePhoneNumbersResponse)),
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+			}
+		case req.Method == http.MethodDelete:
+			released = strings.HasSuffix(req.URL.Path, "/IncomingPhoneNumbers/PNXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX.json")
+
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(``)),
+				StatusCode: http.StatusNoContent,
+				Header:     make(http.Header),
+			}
+		case strings.HasSuffix(req.URL.Path, "/IncomingPhoneNumbers.json"):
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(createdIncomingPhoneNumberResponse)),
+				StatusCode: http.StatusCreated,
+				Header:     make(http.Header),
+			}
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(alreadyAddedToServiceResponse)),
+			StatusCode: http.StatusBadRequest,
+			Header:     make(http.Header),
+		}
+	})
+
+	report, err := twilio.ProvisionPhoneNumber("CA", twiligo.Local, twiligo.ProvisionPhoneNumberOptions{
+		ProxyServiceSID: "KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	})
+
+	expected := "PhoneNumber has already been added to Service"
+
+	if err == nil || err.Error() != expected {
+		t.Logf("Incorrect error returned, expected [%s], but received [%v]", expected, err)
+		t.Fail()
+	}
+
+	if released == false {
+		t.Log("Purchased phone number was not released")
+		t.Fail()
+	}
+
+	if len(report.Attempts) != 1 || report.Attempts[0].FailedStep != "proxy" || report.Attempts[0].Released == false || report.IncomingPhoneNumber != nil {
+		t.Logf("Incorrect attempts reported, received [%v]", report.Attempts)
+		t.Fail()
+	}
+}
+
+func TestWillReportWhenNoCandidatesAreAvailableForProvisioning(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		if req.Method != http.MethodGet {
+			t.Logf("Request was incorrectly made, was not expecting the following request: %v", req)
+			t.FailNow()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"available_phone_numbers": []}`)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	report, err := twilio.ProvisionPhoneNumber("CA", twiligo.Local, twiligo.ProvisionPhoneNumberOptions{})
+
+	if err == nil || len(report.Candidates) != 0 || len(report.Attempts) != 0 {
+		t.Logf("Incorrect outcome reported, received [%v] and [%v]", report, err)
+		t.Fail()
+	}
+}
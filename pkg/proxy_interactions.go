@@ -0,0 +1,235 @@
+package twiligo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+// This constant is used to represent whether a ProxyInteraction was a message or a voice call.
+const (
+	MessageProxyInteraction ProxyInteractionType = iota + 1
+	VoiceProxyInteraction
+	UnknownProxyInteraction
+)
+
+// This constant is used to represent the status of the inbound or outbound message or call behind a ProxyInteraction.
+const (
+	AcceptedResource ProxyResourceStatus = iota + 1
+	AnsweredResource
+	BusyResource
+	CanceledResource
+	CompletedResource
+	DeletedResource
+	DeliveredResource
+	DeliveryUnknownResource
+	FailedResource
+	InProgressResource
+	InitiatedResource
+	NoAnswerResource
+	QueuedResource
+	ReceivedResource
+	ReceivingResource
+	RingingResource
+	ScheduledResource
+	SendingResource
+	SentResource
+	UndeliveredResource
+	UnknownResource
+)
+
+// ProxyInteraction represents a single message or call between the participants of a ProxySession, made up of the inbound leg from one participant to their proxy number and the outbound leg from a proxy number to the other participant.
+type ProxyInteraction struct {
+	SID                    string               `json:"sid"`
+	AccountSID             string               `json:"account_sid"`
+	ServiceSID             string               `json:"service_sid"`
+	SessionSID             string               `json:"session_sid"`
+	ParticipantSID         *string              `json:"participant_sid"`
+	Data                   string               `json:"data"`
+	Type                   ProxyInteractionType `json:"type"`
+	InboundParticipantSID  *string              `json:"inbound_participant_sid"`
+	InboundResourceSID     *string              `json:"inbound_resource_sid"`
+	InboundResourceStatus  ProxyResourceStatus  `json:"inbound_resource_status"`
+	InboundResourceType    *string              `json:"inbound_resource_type"`
+	InboundResourceURL     *string              `json:"inbound_resource_url"`
+	OutboundParticipantSID *string              `json:"outbound_participant_sid"`
+	OutboundResourceSID    *string              `json:"outbound_resource_sid"`
+	OutboundResourceStatus ProxyResourceStatus  `json:"outbound_resource_status"`
+	OutboundResourceType   *string              `json:"outbound_resource_type"`
+	OutboundResourceURL    *string              `json:"outbound_resource_url"`
+	DateCreated            time.Time            `json:"date_created"`
+	DateUpdated            time.Time            `json:"date_updated"`
+	URL                    string               `json:"url"`
+}
+
+// ProxyInteractionsResponse is the representation of the JSON response from Twilio when listing the interactions of a proxy session.
+type ProxyInteractionsResponse struct {
+	Interactions []*ProxyInteraction `json:"interactions"`
+	Meta         Meta                `json:"meta"`
+}
+
+// ProxyInteractionType is used to define whether a ProxyInteraction was a message or a voice call.
+type ProxyInteractionType int
+
+// ProxyResourceStatus is used to define the status of the inbound or outbound message or call behind a ProxyInteraction.
+type ProxyResourceStatus int
+
+// FetchProxyInteraction retrieves the interaction matching the given identifier from the given session in Twilio.
+func (twilio *Twilio) FetchProxyInteraction(serviceSID, sessionSID, interactionSID string) (*ProxyInteraction, error) {
+	res, err := twilio.get(twilio.proxyURL("Services/"+serviceSID+"/Sessions/"+url.PathEscape(sessionSID)+"/Interactions/"+interactionSID), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ProxyInteraction)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// ListProxyInteractions retrieves a single page of the interactions that have taken place within the given session from Twilio.
+func (twilio *Twilio) ListProxyInteractions(serviceSID, sessionSID string, options PageOptions) (*ProxyInteractionsResponse, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.get(twilio.proxyURL("Services/"+serviceSID+"/Sessions/"+url.PathEscape(sessionSID)+"/Interactions"), &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ProxyInteractionsResponse)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// DeleteProxyInteraction will remove the interaction matching the given identifier from the given session within Twilio. The underlying messages and calls are not deleted.
+func (twilio *Twilio) DeleteProxyInteraction(serviceSID, sessionSID, interactionSID string) error {
+	res, err := twilio.delete(twilio.proxyURL("Services/" + serviceSID + "/Sessions/" + url.PathEscape(sessionSID) + "/Interactions/" + interactionSID))
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		decoder := json.NewDecoder(res.Body)
+
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON handles converting a ProxyInteractionType into the string representation used by Twilio.
+func (interactionType ProxyInteractionType) MarshalJSON() ([]byte, error) {
+	return json.Marshal(interactionType.String())
+}
+
+// UnmarshalJSON handles converting the string representation used by Twilio into a ProxyInteractionType.
+func (interactionType *ProxyInteractionType) UnmarshalJSON(b []byte) error {
+	var s string
+
+	err := json.Unmarshal(b, &s)
+
+	if err != nil {
+		return err
+	}
+
+	*interactionType = ConvertTypeToProxyInteractionType(s)
+
+	return nil
+}
+
+func (interactionType ProxyInteractionType) String() string {
+	return map[ProxyInteractionType]string{
+		MessageProxyInteraction: "message",
+		VoiceProxyInteraction:   "voice",
+		UnknownProxyInteraction: "unknown",
+	}[interactionType]
+}
+
+// MarshalJSON handles converting a ProxyResourceStatus into the string representation used by Twilio.
+func (status ProxyResourceStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(status.String())
+}
+
+// UnmarshalJSON handles converting the string representation used by Twilio into a ProxyResourceStatus. A null status, such as the outbound status of an interaction that was never forwarded, is left as the zero value.
+func (status *ProxyResourceStatus) UnmarshalJSON(b []byte) error {
+	var s *string
+
+	err := json.Unmarshal(b, &s)
+
+	if err != nil || s == nil {
+		return err
+	}
+
+	*status = ConvertStatusToProxyResourceStatus(*s)
+
+	return nil
+}
+
+func (status ProxyResourceStatus) String() string {
+	return map[ProxyResourceStatus]string{
+		AcceptedResource:        "accepted",
+		AnsweredResource:        "answered",
+		BusyResource:            "busy",
+		CanceledResource:        "canceled",
+		CompletedResource:       "completed",
+		DeletedResource:         "deleted",
+		DeliveredResource:       "delivered",
+		DeliveryUnknownResource: "delivery-unknown",
+		FailedResource:          "failed",
+		InProgressResource:      "in-progress",
+		InitiatedResource:       "initiated",
+		NoAnswerResource:        "no-answer",
+		QueuedResource:          "queued",
+		ReceivedResource:        "received",
+		ReceivingResource:       "receiving",
+		RingingResource:         "ringing",
+		ScheduledResource:       "scheduled",
+		SendingResource:         "sending",
+		SentResource:            "sent",
+		UndeliveredResource:     "undelivered",
+		UnknownResource:         "unknown",
+	}[status]
+}
@@ -0,0 +1,97 @@
+package twiligo_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"testing"
+
+	twiligo "github.com/craigpaul/twiligo/pkg"
+)
+
+const proxyInteractionResponse = `{
+	"sid": "KIXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"account_sid": "ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"service_sid": "KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"session_sid": "KCXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"data": "{\"body\":\"Hello\"}",
+	"type": "message",
+	"inbound_participant_sid": "KPXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"inbound_resource_sid": "SMXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"inbound_resource_status": "received",
+	"inbound_resource_type": "Message",
+	"inbound_resource_url": null,
+	"outbound_participant_sid": "KPYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY",
+	"outbound_resource_sid": "SMYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY",
+	"outbound_resource_status": "delivered",
+	"outbound_resource_type": "Message",
+	"outbound_resource_url": null,
+	"date_created": "2020-07-30T00:00:00Z",
+	"date_updated": "2020-07-30T00:00:00Z",
+	"url": "https://proxy.twilio.com/v1/Services/KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Sessions/KCXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Interactions/KIXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}`
+
+func TestWillMakeRequestToListProxyInteractionsSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Services/KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Sessions/KCXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Interactions"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"interactions": [` + proxyInteractionResponse + `], "meta": {"page": 0, "page_size": 50, "key": "interactions"}}`)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.ListProxyInteractions("KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "KCXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.PageOptions{})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if len(response.Interactions) != 1 {
+		t.Logf("Incorrect interactions decoded, received [%v]", response.Interactions)
+		t.FailNow()
+	}
+
+	interaction := response.Interactions[0]
+
+	if interaction.InboundResourceStatus != twiligo.ReceivedResource || interaction.OutboundResourceStatus != twiligo.DeliveredResource {
+		t.Logf("Incorrect resource statuses decoded, received [%s] and [%s]", interaction.InboundResourceStatus, interaction.OutboundResourceStatus)
+		t.Fail()
+	}
+
+	if *interaction.InboundParticipantSID != "KPXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX" || *interaction.OutboundParticipantSID != "KPYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY" {
+		t.Logf("Incorrect participants decoded, received [%v]", interaction)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToDeleteProxyInteractionSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Services/KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Sessions/KCXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Interactions/KIXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+
+		if req.Method != http.MethodDelete || req.URL.Path != expected {
+			t.Logf("Incorrect request supplied, expecting [DELETE %s], but received [%s %s]", expected, req.Method, req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(``)),
+			StatusCode: http.StatusNoContent,
+			Header:     make(http.Header),
+		}
+	})
+
+	err := twilio.DeleteProxyInteraction("KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "KCXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "KIXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX")
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+}
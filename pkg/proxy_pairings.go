@@ -0,0 +1,192 @@
+package twiligo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+const (
+	// ParticipantAlreadyInSessionErrorCode is the error code returned by Twilio when the given identifier is already a participant of the session, or is already paired through the same proxy number in another session.
+	ParticipantAlreadyInSessionErrorCode = 80103
+	// NoAvailableProxyNumberErrorCode is the error code returned by Twilio when every phone number in the proxy service is already in use by the given identifier.
+	NoAvailableProxyNumberErrorCode = 80207
+)
+
+// NoAvailableProxyNumberError is returned by PairProxyParticipants when the proxy service has no phone number left that the given identifier could be masked behind. Adding more phone numbers to the service resolves it.
+type NoAvailableProxyNumberError struct {
+	*Exception
+	SessionSID string
+	Identifier string
+}
+
+// ParticipantAlreadyInSessionError is returned by PairProxyParticipants when Twilio refuses to add the given identifier to the session because it is already taking part in it.
+type ParticipantAlreadyInSessionError struct {
+	*Exception
+	SessionSID string
+	Identifier string
+}
+
+// ProxyPairing represents two real phone numbers connected to each other through a ProxySession. Each side reaches the other by dialing or texting the ProxyIdentifier of their own participant.
+type ProxyPairing struct {
+	Session *ProxySession
+	First   *ProxyParticipant
+	Second  *ProxyParticipant
+}
+
+// Unwrap returns the underlying exception returned by Twilio.
+func (e *NoAvailableProxyNumberError) Unwrap() error {
+	return e.Exception
+}
+
+// Unwrap returns the underlying exception returned by Twilio.
+func (e *ParticipantAlreadyInSessionError) Unwrap() error {
+	return e.Exception
+}
+
+// PairProxyParticipants connects the two given identifiers through a session of the given proxy service, reusing the session matching the given unique name and any participants already in it.
+func (twilio *Twilio) PairProxyParticipants(serviceSID, uniqueName, first, second string, options ProxySessionOptions) (*ProxyPairing, error) {
+	session, err := twilio.findOrCreateProxySession(serviceSID, uniqueName, options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	participants, err := twilio.listAllProxyParticipants(serviceSID, session.SID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pairing := &ProxyPairing{Session: session}
+
+	pairing.First, err = twilio.findOrCreateProxyParticipant(serviceSID, session.SID, first, participants)
+
+	if err != nil {
+		return nil, err
+	}
+
+	pairing.Second, err = twilio.findOrCreateProxyParticipant(serviceSID, session.SID, second, participants)
+
+	if err == nil {
+		return pairing, nil
+	}
+
+	if containsProxyParticipant(participants, pairing.First) {
+		return nil, err
+	}
+
+	cleanupErr := twilio.DeleteProxyParticipant(serviceSID, session.SID, pairing.First.SID)
+
+	if cleanupErr != nil {
+		return nil, fmt.Errorf("Unable to remove participant %s after failing to add %s (%s): %w", pairing.First.SID, second, cleanupErr, err)
+	}
+
+	return nil, err
+}
+
+// UnpairProxyParticipants tears down the session created by PairProxyParticipants for the given unique name, removing both participants and releasing their proxy numbers. A session that no longer exists is not considered an error.
+func (twilio *Twilio) UnpairProxyParticipants(serviceSID, uniqueName string) error {
+	err := twilio.DeleteProxySession(serviceSID, uniqueName)
+
+	if isNotFoundException(err) {
+		return nil
+	}
+
+	return err
+}
+
+func (twilio *Twilio) findOrCreateProxySession(serviceSID, uniqueName string, options ProxySessionOptions) (*ProxySession, error) {
+	session, err := twilio.FetchProxySession(serviceSID, uniqueName)
+
+	if err == nil && session.Status == ClosedSession {
+		return twilio.UpdateProxySession(serviceSID, session.SID, ProxySessionOptions{
+			Status:     InProgressSession,
+			TTL:        options.TTL,
+			DateExpiry: options.DateExpiry,
+		})
+	}
+
+	if err == nil && session.Status == FailedSession {
+		err = twilio.DeleteProxySession(serviceSID, session.SID)
+
+		if err != nil {
+			return nil, err
+		}
+
+		err = &Exception{Status: http.StatusNotFound}
+	}
+
+	if isNotFoundException(err) {
+		options.UniqueName = uniqueName
+
+		return twilio.CreateNewProxySession(serviceSID, options)
+	}
+
+	return session, err
+}
+
+func (twilio *Twilio) listAllProxyParticipants(serviceSID, sessionSID string) ([]*ProxyParticipant, error) {
+	participants := []*ProxyParticipant{}
+
+	options := PageOptions{}
+
+	for {
+		response, err := twilio.ListProxyParticipants(serviceSID, sessionSID, options)
+
+		if err != nil {
+			return nil, err
+		}
+
+		participants = append(participants, response.Participants...)
+
+		next := response.Meta.NextPage()
+
+		if next == nil {
+			return participants, nil
+		}
+
+		options = *next
+	}
+}
+
+func (twilio *Twilio) findOrCreateProxyParticipant(serviceSID, sessionSID, identifier string, participants []*ProxyParticipant) (*ProxyParticipant, error) {
+	for _, participant := range participants {
+		if participant.Identifier == identifier {
+			return participant, nil
+		}
+	}
+
+	participant, err := twilio.CreateNewProxyParticipant(serviceSID, sessionSID, identifier, ProxyParticipantOptions{})
+
+	var exception *Exception
+
+	if errors.As(err, &exception) == false {
+		return participant, err
+	}
+
+	switch exception.Code {
+	case ParticipantAlreadyInSessionErrorCode:
+		return nil, &ParticipantAlreadyInSessionError{Exception: exception, SessionSID: sessionSID, Identifier: identifier}
+	case NoAvailableProxyNumberErrorCode:
+		return nil, &NoAvailableProxyNumberError{Exception: exception, SessionSID: sessionSID, Identifier: identifier}
+	}
+
+	return nil, err
+}
+
+func containsProxyParticipant(participants []*ProxyParticipant, participant *ProxyParticipant) bool {
+	for _, existing := range participants {
+		if existing.SID == participant.SID {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isNotFoundException(err error) bool {
+	var exception *Exception
+
+	return errors.As(err, &exception) && exception.Status == http.StatusNotFound
+}
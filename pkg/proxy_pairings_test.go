@@ -0,0 +1,362 @@
+package twiligo_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	twiligo "github.com/craigpaul/twiligo/pkg"
+)
+
+const noAvailableProxyNumberResponse = `{
+	"code": 80207,
+	"message": "No available proxy number for participant",
+	"more_info": "https://www.twilio.com/docs/errors/80207",
+	"status": 400
+}`
+
+func TestWillCreateSessionAndAddMissingParticipantsWhenPairingProxyParticipants(t *testing.T) {
+	requests := []string{}
+
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+
+		switch {
+		case req.Method == http.MethodGet && strings.HasSuffix(req.URL.Path, "/Sessions/order-1234"):
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(proxySessionNotFoundResponse)),
+				StatusCode: http.StatusNotFound,
+				Header:     make(http.Header),
+			}
+		case req.Method == http.MethodPost && strings.HasSuffix(req.URL.Path, "/Sessions"):
+			body, _ := ioutil.ReadAll(req.Body)
+			params, _ := url.ParseQuery(string(body))
+
+			if params.Get("UniqueName") != "order-1234" {
+				t.Logf("Incorrect request parameter supplied, expecting [%s], but received [%s]", "order-1234", params.Get("UniqueName"))
+				t.Fail()
+			}
+
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(proxySessionResponse)),
+				StatusCode: http.StatusCreated,
+				Header:     make(http.Header),
+			}
+		case req.Method == http.MethodGet:
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{"participants": [` + proxyParticipantResponse + `], "meta": {"page": 0, "page_size": 50, "key": "participants"}}`)),
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+			}
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		if params.Get("Identifier") != "+15555555557" {
+			t.Logf("Incorrect request parameter supplied, expecting [%s], but received [%s]", "+15555555557", params.Get("Identifier"))
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(strings.Replace(proxyParticipantResponse, `"identifier": "+15555555555"`, `"identifier": "+15555555557"`, 1))),
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+		}
+	})
+
+	pairing, err := twilio.PairProxyParticipants("KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "order-1234", "+15555555555", "+15555555557", twiligo.ProxySessionOptions{Mode: twiligo.MessageOnlySession})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if pairing.Session.SID != "KCXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX" || pairing.First.Identifier != "+15555555555" || pairing.Second.Identifier != "+15555555557" {
+		t.Logf("Incorrect pairing returned, received [%v]", pairing)
+		t.Fail()
+	}
+
+	expected := []string{
+		"GET /v1/Services/KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Sessions/order-1234",
+		"POST /v1/Services/KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Sessions",
+		"GET /v1/Services/KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Sessions/KCXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Participants",
+		"POST /v1/Services/KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Sessions/KCXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Participants",
+	}
+
+	if strings.Join(requests, ",") != strings.Join(expected, ",") {
+		t.Logf("Incorrect requests made, expected [%v], but received [%v]", expected, requests)
+		t.Fail()
+	}
+}
+
+func TestWillReturnTypedErrorWhenNoProxyNumberIsAvailableForPairing(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		switch {
+		case req.Method == http.MethodGet && strings.HasSuffix(req.URL.Path, "/Participants"):
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{"participants": [], "meta": {"page": 0, "page_size": 50, "key": "participants"}}`)),
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+			}
+		case req.Method == http.MethodGet:
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(proxySessionResponse)),
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+			}
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(noAvailableProxyNumberResponse)),
+			StatusCode: http.StatusBadRequest,
+			Header:     make(http.Header),
+		}
+	})
+
+	pairing, err := twilio.PairProxyParticipants("KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "order-1234", "+15555555555", "+15555555557", twiligo.ProxySessionOptions{})
+
+	if pairing != nil {
+		t.Logf("Response was incorrectly returned, was not expecting the following response: %v", pairing)
+		t.Fail()
+	}
+
+	var unavailable *twiligo.NoAvailableProxyNumberError
+
+	if errors.As(err, &unavailable) == false || unavailable.Identifier != "+15555555555" || unavailable.Code != twiligo.NoAvailableProxyNumberErrorCode {
+		t.Logf("Incorrect error returned, expected NoAvailableProxyNumberError, but received [%v]", err)
+		t.Fail()
+	}
+}
+
+func TestWillReopenClosedSessionWhenPairingProxyParticipants(t *testing.T) {
+	requests := []string{}
+
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+
+		switch {
+		case req.Method == http.MethodGet && strings.HasSuffix(req.URL.Path, "/Participants"):
+			second := strings.NewReplacer("KPXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "KPYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY", `"identifier": "+15555555555"`, `"identifier": "+15555555557"`).Replace(proxyParticipantResponse)
+
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{"participants": [` + proxyParticipantResponse + `, ` + second + `], "meta": {"page": 0, "page_size": 50, "key": "participants"}}`)),
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+			}
+		case req.Method == http.MethodGet:
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(strings.Replace(proxySessionResponse, `"status": "open"`, `"status": "closed"`, 1))),
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+			}
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		if params.Get("Status") != "in-progress" {
+			t.Logf("Incorrect request parameter supplied, expecting [%s], but received [%s]", "in-progress", params.Get("Status"))
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(strings.Replace(proxySessionResponse, `"status": "open"`, `"status": "in-progress"`, 1))),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	pairing, err := twilio.PairProxyParticipants("KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "order-1234", "+15555555555", "+15555555557", twiligo.ProxySessionOptions{})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if pairing.Session.Status != twiligo.InProgressSession {
+		t.Logf("Incorrect session status returned, expected [%s], but received [%s]", twiligo.InProgressSession, pairing.Session.Status)
+		t.Fail()
+	}
+
+	expected := []string{
+		"GET /v1/Services/KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Sessions/order-1234",
+		"POST /v1/Services/KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Sessions/KCXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+		"GET /v1/Services/KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Sessions/KCXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Participants",
+	}
+
+	if strings.Join(requests, ",") != strings.Join(expected, ",") {
+		t.Logf("Incorrect requests made, expected [%v], but received [%v]", expected, requests)
+		t.Fail()
+	}
+}
+
+func TestWillRecreateFailedSessionWhenPairingProxyParticipants(t *testing.T) {
+	requests := []string{}
+
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+
+		switch {
+		case req.Method == http.MethodGet && strings.HasSuffix(req.URL.Path, "/Participants"):
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{"participants": [], "meta": {"page": 0, "page_size": 50, "key": "participants"}}`)),
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+			}
+		case req.Method == http.MethodGet:
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(strings.Replace(proxySessionResponse, `"status": "open"`, `"status": "failed"`, 1))),
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+			}
+		case req.Method == http.MethodDelete:
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(``)),
+				StatusCode: http.StatusNoContent,
+				Header:     make(http.Header),
+			}
+		case strings.HasSuffix(req.URL.Path, "/Sessions"):
+			body, _ := ioutil.ReadAll(req.Body)
+			params, _ := url.ParseQuery(string(body))
+
+			if params.Get("UniqueName") != "order-1234" {
+				t.Logf("Incorrect request parameter supplied, expecting [%s], but received [%s]", "order-1234", params.Get("UniqueName"))
+				t.Fail()
+			}
+
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(proxySessionResponse)),
+				StatusCode: http.StatusCreated,
+				Header:     make(http.Header),
+			}
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(proxyParticipantResponse)),
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+		}
+	})
+
+	pairing, err := twilio.PairProxyParticipants("KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "order-1234", "+15555555555", "+15555555557", twiligo.ProxySessionOptions{})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if pairing.Session.Status != twiligo.OpenSession {
+		t.Logf("Incorrect session status returned, expected [%s], but received [%s]", twiligo.OpenSession, pairing.Session.Status)
+		t.Fail()
+	}
+
+	expected := []string{
+		"GET /v1/Services/KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Sessions/order-1234",
+		"DELETE /v1/Services/KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Sessions/KCXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+		"POST /v1/Services/KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Sessions",
+		"GET /v1/Services/KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Sessions/KCXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Participants",
+		"POST /v1/Services/KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Sessions/KCXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Participants",
+		"POST /v1/Services/KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Sessions/KCXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Participants",
+	}
+
+	if strings.Join(requests, ",") != strings.Join(expected, ",") {
+		t.Logf("Incorrect requests made, expected [%v], but received [%v]", expected, requests)
+		t.Fail()
+	}
+}
+
+func TestWillRemoveNewFirstParticipantWhenSecondParticipantCannotBePaired(t *testing.T) {
+	deleted := ""
+
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		switch {
+		case req.Method == http.MethodGet && strings.HasSuffix(req.URL.Path, "/Participants"):
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{"participants": [], "meta": {"page": 0, "page_size": 50, "key": "participants"}}`)),
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+			}
+		case req.Method == http.MethodGet:
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(proxySessionResponse)),
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+			}
+		case req.Method == http.MethodDelete:
+			deleted = req.URL.Path
+
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(``)),
+				StatusCode: http.StatusNoContent,
+				Header:     make(http.Header),
+			}
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		if params.Get("Identifier") == "+15555555557" {
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(noAvailableProxyNumberResponse)),
+				StatusCode: http.StatusBadRequest,
+				Header:     make(http.Header),
+			}
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(proxyParticipantResponse)),
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+		}
+	})
+
+	pairing, err := twilio.PairProxyParticipants("KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "order-1234", "+15555555555", "+15555555557", twiligo.ProxySessionOptions{})
+
+	if pairing != nil {
+		t.Logf("Response was incorrectly returned, was not expecting the following response: %v", pairing)
+		t.Fail()
+	}
+
+	var unavailable *twiligo.NoAvailableProxyNumberError
+
+	if errors.As(err, &unavailable) == false || unavailable.Identifier != "+15555555557" {
+		t.Logf("Incorrect error returned, expected NoAvailableProxyNumberError, but received [%v]", err)
+		t.Fail()
+	}
+
+	expected := "/v1/Services/KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Sessions/KCXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Participants/KPXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+
+	if deleted != expected {
+		t.Logf("Expected the newly added participant to be removed at [%s], but received [%s]", expected, deleted)
+		t.Fail()
+	}
+}
+
+func TestWillIgnoreMissingSessionWhenUnpairingProxyParticipants(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Services/KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Sessions/order-1234"
+
+		if req.Method != http.MethodDelete || req.URL.Path != expected {
+			t.Logf("Incorrect request supplied, expecting [DELETE %s], but received [%s %s]", expected, req.Method, req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(proxySessionNotFoundResponse)),
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+		}
+	})
+
+	err := twilio.UnpairProxyParticipants("KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "order-1234")
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+}
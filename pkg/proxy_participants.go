@@ -0,0 +1,199 @@
+package twiligo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+// ProxyMessageInteractionOptions are all of the options that can be provided to a CreateNewProxyMessageInteraction call. At least one of Body or MediaURL must be provided.
+type ProxyMessageInteractionOptions struct {
+	Body     string   `url:",omitempty"`
+	MediaURL []string `url:"MediaUrl,omitempty"`
+}
+
+// ProxyParticipant represents a single real phone number (or other identifier) that has been added to a ProxySession, along with the proxy number it communicates through.
+type ProxyParticipant struct {
+	SID                string     `json:"sid"`
+	AccountSID         string     `json:"account_sid"`
+	ServiceSID         string     `json:"service_sid"`
+	SessionSID         string     `json:"session_sid"`
+	FriendlyName       *string    `json:"friendly_name"`
+	Identifier         string     `json:"identifier"`
+	ProxyIdentifier    string     `json:"proxy_identifier"`
+	ProxyIdentifierSID string     `json:"proxy_identifier_sid"`
+	DateDeleted        *time.Time `json:"date_deleted"`
+	DateCreated        time.Time  `json:"date_created"`
+	DateUpdated        time.Time  `json:"date_updated"`
+	URL                string     `json:"url"`
+	Links              struct {
+		MessageInteractions string `json:"message_interactions"`
+	} `json:"links"`
+}
+
+// ProxyParticipantOptions are all of the options that can be provided to a CreateNewProxyParticipant call. ProxyIdentifier (or ProxyIdentifierSID) pins the participant to a specific proxy number instead of letting Twilio choose one from the service.
+type ProxyParticipantOptions struct {
+	FriendlyName       string `url:",omitempty"`
+	ProxyIdentifier    string `url:",omitempty"`
+	ProxyIdentifierSID string `url:"ProxyIdentifierSid,omitempty"`
+}
+
+// ProxyParticipantsResponse is the representation of the JSON response from Twilio when listing the participants of a proxy session.
+type ProxyParticipantsResponse struct {
+	Participants []*ProxyParticipant `json:"participants"`
+	Meta         Meta                `json:"meta"`
+}
+
+// CreateNewProxyParticipant adds the given identifier, usually a phone number in E.164 format, to the given session as a new participant in Twilio.
+func (twilio *Twilio) CreateNewProxyParticipant(serviceSID, sessionSID, identifier string, options ProxyParticipantOptions) (*ProxyParticipant, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	params.Add("Identifier", identifier)
+
+	res, err := twilio.post(twilio.proxyURL("Services/"+serviceSID+"/Sessions/"+url.PathEscape(sessionSID)+"/Participants"), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusCreated {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ProxyParticipant)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// FetchProxyParticipant retrieves the participant matching the given identifier from the given session in Twilio.
+func (twilio *Twilio) FetchProxyParticipant(serviceSID, sessionSID, participantSID string) (*ProxyParticipant, error) {
+	res, err := twilio.get(twilio.proxyURL("Services/"+serviceSID+"/Sessions/"+url.PathEscape(sessionSID)+"/Participants/"+participantSID), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ProxyParticipant)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// ListProxyParticipants retrieves a single page of the participants within the given session from Twilio.
+func (twilio *Twilio) ListProxyParticipants(serviceSID, sessionSID string, options PageOptions) (*ProxyParticipantsResponse, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.get(twilio.proxyURL("Services/"+serviceSID+"/Sessions/"+url.PathEscape(sessionSID)+"/Participants"), &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ProxyParticipantsResponse)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// DeleteProxyParticipant will remove the participant matching the given identifier from the given session within Twilio.
+func (twilio *Twilio) DeleteProxyParticipant(serviceSID, sessionSID, participantSID string) error {
+	res, err := twilio.delete(twilio.proxyURL("Services/" + serviceSID + "/Sessions/" + url.PathEscape(sessionSID) + "/Participants/" + participantSID))
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		decoder := json.NewDecoder(res.Body)
+
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return err
+	}
+
+	return nil
+}
+
+// CreateNewProxyMessageInteraction sends a message to the given participant from their proxy number, as though it had been sent by the other participants of the session, in Twilio.
+func (twilio *Twilio) CreateNewProxyMessageInteraction(serviceSID, sessionSID, participantSID string, options ProxyMessageInteractionOptions) (*ProxyInteraction, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.proxyURL("Services/"+serviceSID+"/Sessions/"+url.PathEscape(sessionSID)+"/Participants/"+participantSID+"/MessageInteractions"), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusCreated {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ProxyInteraction)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
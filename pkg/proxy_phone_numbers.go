@@ -41,6 +41,17 @@ type ProxyPhoneNumberCapabilities struct {
 	VoiceOutbound bool `json:"voice_outbound"`
 }
 
+// ProxyPhoneNumbersResponse is the representation of the JSON response from Twilio when listing the phone numbers of a proxy service.
+type ProxyPhoneNumbersResponse struct {
+	PhoneNumbers []*ProxyPhoneNumber `json:"phone_numbers"`
+	Meta         Meta                `json:"meta"`
+}
+
+// UpdateProxyPhoneNumberOptions are all of the options that can be provided to an UpdateProxyPhoneNumber call. A reserved phone number is only used when explicitly requested as a ProxyIdentifier, never when Twilio selects a number automatically.
+type UpdateProxyPhoneNumberOptions struct {
+	IsReserved *bool `url:",omitempty"`
+}
+
 // AddPhoneNumberToProxyService attaches a phone number to the given proxy service in Twilio.
 func (twilio *Twilio) AddPhoneNumberToProxyService(serviceSID string, options AddPhoneNumberToProxyServiceOptions) (*ProxyPhoneNumber, error) {
 	params, err := query.Values(options)
@@ -74,12 +85,105 @@ func (twilio *Twilio) AddPhoneNumberToProxyService(serviceSID string, options Ad
 	return response, nil
 }
 
+// FetchProxyPhoneNumber retrieves the phone number matching the given identifier from the given proxy service in Twilio.
+func (twilio *Twilio) FetchProxyPhoneNumber(serviceSID, phoneNumberSID string) (*ProxyPhoneNumber, error) {
+	res, err := twilio.get(twilio.proxyURL("Services/"+serviceSID+"/PhoneNumbers/"+phoneNumberSID), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ProxyPhoneNumber)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// ListProxyPhoneNumbers retrieves a single page of the phone numbers attached to the given proxy service from Twilio.
+func (twilio *Twilio) ListProxyPhoneNumbers(serviceSID string, options PageOptions) (*ProxyPhoneNumbersResponse, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.get(twilio.proxyURL("Services/"+serviceSID+"/PhoneNumbers"), &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ProxyPhoneNumbersResponse)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// UpdateProxyPhoneNumber will update an existing phone number within the given proxy service in Twilio based on the provided identifier and options.
+func (twilio *Twilio) UpdateProxyPhoneNumber(serviceSID, phoneNumberSID string, options UpdateProxyPhoneNumberOptions) (*ProxyPhoneNumber, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.proxyURL("Services/"+serviceSID+"/PhoneNumbers/"+phoneNumberSID), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ProxyPhoneNumber)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
 // RemovePhoneNumberFromProxyService remove the given IncomingPhoneNumber from the given ProxyService within Twilio.
 func (twilio *Twilio) RemovePhoneNumberFromProxyService(serviceSID, phoneNumberSID string) error {
 	res, err := twilio.delete(twilio.proxyURL("Services/" + serviceSID + "/PhoneNumbers/" + phoneNumberSID))
 
 	if err != nil {
-		return nil
+		return err
 	}
 
 	defer res.Body.Close()
@@ -195,3 +195,106 @@ func TestWillHandleErrorResponsesWhenMakingRequestToRemovePhoneNumberFromProxySe
 		t.Fail()
 	}
 }
+
+func TestWillReturnTransportErrorsWhenMakingRequestToRemovePhoneNumberFromProxyService(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		return nil
+	})
+
+	err := twilio.RemovePhoneNumberFromProxyService("KS123", "PN123")
+
+	if err == nil {
+		t.Log("Error was not returned, was expecting the transport error")
+		t.Fail()
+	}
+}
+
+const proxyPhoneNumberResponse = `{
+	"sid": "PNXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"account_sid": "ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"service_sid": "KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"date_created": "2020-07-30T00:00:00Z",
+	"date_updated": "2020-07-30T00:00:00Z",
+	"phone_number": "+15555555555",
+	"friendly_name": "(555) 555-5555",
+	"iso_country": "CA",
+	"capabilities": {
+		"mms_inbound": true,
+		"mms_outbound": true,
+		"sms_inbound": true,
+		"sms_outbound": true,
+		"voice_inbound": true,
+		"voice_outbound": true
+	},
+	"url": "https://proxy.twilio.com/v1/Services/KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/PhoneNumbers/PNXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"is_reserved": true,
+	"in_use": 3
+}`
+
+func TestWillMakeRequestToListProxyPhoneNumbersSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Services/KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/PhoneNumbers"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"phone_numbers": [` + proxyPhoneNumberResponse + `], "meta": {"page": 0, "page_size": 50, "key": "phone_numbers"}}`)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.ListProxyPhoneNumbers("KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.PageOptions{})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if len(response.PhoneNumbers) != 1 || response.PhoneNumbers[0].InUse != 3 {
+		t.Logf("Incorrect phone numbers decoded, received [%v]", response.PhoneNumbers)
+		t.Fail()
+	}
+}
+
+func TestWillIncludeProperRequestBodyParametersWhenMakingRequestToUpdateProxyPhoneNumber(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Services/KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/PhoneNumbers/PNXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+
+		if req.Method != http.MethodPost || req.URL.Path != expected {
+			t.Logf("Incorrect request supplied, expecting [POST %s], but received [%s %s]", expected, req.Method, req.URL.Path)
+			t.Fail()
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		if params.Get("IsReserved") != "true" {
+			t.Logf("Incorrect request parameter supplied, expecting [%s], but received [%s]", "true", params.Get("IsReserved"))
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(proxyPhoneNumberResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	reserved := true
+
+	response, err := twilio.UpdateProxyPhoneNumber("KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "PNXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.UpdateProxyPhoneNumberOptions{IsReserved: &reserved})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if response.IsReserved == false {
+		t.Log("Incorrect reservation decoded, expecting phone number to be reserved")
+		t.Fail()
+	}
+}
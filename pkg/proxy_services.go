@@ -60,6 +60,18 @@ type ProxyService struct {
 	} `json:"links"`
 }
 
+// ProxyServicesResponse is the representation of the JSON response from Twilio when listing proxy services.
+type ProxyServicesResponse struct {
+	Services []*ProxyService `json:"services"`
+	Meta     Meta            `json:"meta"`
+}
+
+// UpdateProxyServiceOptions are all of the options that can be provided to an UpdateProxyService call. Any option left empty is unchanged.
+type UpdateProxyServiceOptions struct {
+	CreateNewProxyServiceOptions
+	UniqueName string `url:",omitempty"`
+}
+
 // CreateNewProxyService creates a new proxy service in Twilio.
 func (twilio *Twilio) CreateNewProxyService(name string, options CreateNewProxyServiceOptions) (*ProxyService, error) {
 	params, err := query.Values(options)
@@ -95,6 +107,122 @@ func (twilio *Twilio) CreateNewProxyService(name string, options CreateNewProxyS
 	return response, nil
 }
 
+// FetchProxyService retrieves the proxy service matching the given identifier from Twilio.
+func (twilio *Twilio) FetchProxyService(serviceSID string) (*ProxyService, error) {
+	res, err := twilio.get(twilio.proxyURL("Services/"+serviceSID), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ProxyService)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// ListProxyServices retrieves a single page of the proxy services belonging to the current account from Twilio.
+func (twilio *Twilio) ListProxyServices(options PageOptions) (*ProxyServicesResponse, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.get(twilio.proxyURL("Services"), &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ProxyServicesResponse)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// UpdateProxyService will update an existing proxy service in Twilio based on the provided identifier and options.
+func (twilio *Twilio) UpdateProxyService(serviceSID string, options UpdateProxyServiceOptions) (*ProxyService, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.proxyURL("Services/"+serviceSID), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ProxyService)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// DeleteProxyService will remove the proxy service matching the given identifier, along with all of its sessions, within Twilio.
+func (twilio *Twilio) DeleteProxyService(serviceSID string) error {
+	res, err := twilio.delete(twilio.proxyURL("Services/" + serviceSID))
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		decoder := json.NewDecoder(res.Body)
+
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return err
+	}
+
+	return nil
+}
+
 func (geo GeoMatchLevel) String() string {
 	return map[GeoMatchLevel]string{
 		AreaCode:         "area-code",
@@ -143,3 +143,122 @@ func TestWillHandleErrorResponsesWhenMakingRequestToCreateNewProxyService(t *tes
 		t.Fail()
 	}
 }
+
+func TestWillMakeRequestToFetchProxyServiceSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Services/KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+
+		if req.Method != http.MethodGet || req.URL.Path != expected {
+			t.Logf("Incorrect request supplied, expecting [GET %s], but received [%s %s]", expected, req.Method, req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(createdResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.FetchProxyService("KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX")
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if response.UniqueName != "Unique Name" {
+		t.Logf("Incorrect unique name decoded, expecting [%s], but received [%s]", "Unique Name", response.UniqueName)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToListProxyServicesSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"services": [` + createdResponse + `], "meta": {"page": 0, "page_size": 50, "key": "services"}}`)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.ListProxyServices(twiligo.PageOptions{})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if len(response.Services) != 1 {
+		t.Logf("Incorrect services decoded, received [%v]", response.Services)
+		t.Fail()
+	}
+}
+
+func TestWillIncludeProperRequestBodyParametersWhenMakingRequestToUpdateProxyService(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		expected := map[string]string{
+			"UniqueName":    "Renamed",
+			"DefaultTtl":    "3600",
+			"GeoMatchLevel": "area-code",
+		}
+
+		for key, value := range expected {
+			if params.Get(key) != value {
+				t.Logf("Incorrect request parameter supplied for [%s], expecting [%s], but received [%s]", key, value, params.Get(key))
+				t.Fail()
+			}
+		}
+
+		if _, ok := params["CallbackUrl"]; ok {
+			t.Log("Empty request parameter CallbackUrl was incorrectly supplied")
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(createdResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	_, err := twilio.UpdateProxyService("KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.UpdateProxyServiceOptions{
+		CreateNewProxyServiceOptions: twiligo.CreateNewProxyServiceOptions{
+			DefaultTTL:    3600,
+			GeoMatchLevel: twiligo.AreaCode,
+		},
+		UniqueName: "Renamed",
+	})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToDeleteProxyServiceSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Services/KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+
+		if req.Method != http.MethodDelete || req.URL.Path != expected {
+			t.Logf("Incorrect request supplied, expecting [DELETE %s], but received [%s %s]", expected, req.Method, req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(``)),
+			StatusCode: http.StatusNoContent,
+			Header:     make(http.Header),
+		}
+	})
+
+	err := twilio.DeleteProxyService("KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX")
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+}
@@ -0,0 +1,277 @@
+package twiligo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+// This constant is used to represent which kinds of interaction are allowed between the participants of a ProxySession.
+const (
+	MessageOnlySession ProxySessionMode = iota + 1
+	VoiceOnlySession
+	VoiceAndMessageSession
+)
+
+// This constant is used to represent the current status of a particular ProxySession.
+const (
+	OpenSession ProxySessionStatus = iota + 1
+	InProgressSession
+	ClosedSession
+	FailedSession
+	UnknownSession
+)
+
+// ProxySession represents a single masked conversation between the participants of a ProxyService.
+type ProxySession struct {
+	SID                 string             `json:"sid"`
+	AccountSID          string             `json:"account_sid"`
+	ServiceSID          string             `json:"service_sid"`
+	UniqueName          *string            `json:"unique_name"`
+	Mode                ProxySessionMode   `json:"mode"`
+	Status              ProxySessionStatus `json:"status"`
+	ClosedReason        *string            `json:"closed_reason"`
+	TTL                 int                `json:"ttl"`
+	DateStarted         *time.Time         `json:"date_started"`
+	DateEnded           *time.Time         `json:"date_ended"`
+	DateLastInteraction *time.Time         `json:"date_last_interaction"`
+	DateExpiry          *time.Time         `json:"date_expiry"`
+	DateCreated         time.Time          `json:"date_created"`
+	DateUpdated         time.Time          `json:"date_updated"`
+	URL                 string             `json:"url"`
+	Links               struct {
+		Interactions string `json:"interactions"`
+		Participants string `json:"participants"`
+	} `json:"links"`
+}
+
+// ProxySessionMode is used to define which kinds of interaction are allowed between the participants of a ProxySession.
+type ProxySessionMode int
+
+// ProxySessionOptions are all of the options that can be provided to a CreateNewProxySession or UpdateProxySession call. TTL is the number of seconds after the last interaction that the session expires. Note: UniqueName and Mode can only be provided when creating a new session.
+type ProxySessionOptions struct {
+	UniqueName string             `url:",omitempty"`
+	DateExpiry time.Time          `url:",omitempty"`
+	TTL        int                `url:"Ttl,omitempty"`
+	Mode       ProxySessionMode   `url:",omitempty"`
+	Status     ProxySessionStatus `url:",omitempty"`
+}
+
+// ProxySessionsResponse is the representation of the JSON response from Twilio when listing the sessions of a proxy service.
+type ProxySessionsResponse struct {
+	Sessions []*ProxySession `json:"sessions"`
+	Meta     Meta            `json:"meta"`
+}
+
+// ProxySessionStatus is used to define whether a particular ProxySession is open, in progress, closed or has failed.
+type ProxySessionStatus int
+
+// CreateNewProxySession creates a new session within the given proxy service in Twilio.
+func (twilio *Twilio) CreateNewProxySession(serviceSID string, options ProxySessionOptions) (*ProxySession, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.proxyURL("Services/"+serviceSID+"/Sessions"), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusCreated {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ProxySession)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// FetchProxySession retrieves the session matching the given identifier, which may be either the SID or the UniqueName of the session, from the given proxy service in Twilio.
+func (twilio *Twilio) FetchProxySession(serviceSID, sessionSID string) (*ProxySession, error) {
+	res, err := twilio.get(twilio.proxyURL("Services/"+serviceSID+"/Sessions/"+url.PathEscape(sessionSID)), nil)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ProxySession)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// ListProxySessions retrieves a single page of the sessions within the given proxy service from Twilio.
+func (twilio *Twilio) ListProxySessions(serviceSID string, options PageOptions) (*ProxySessionsResponse, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.get(twilio.proxyURL("Services/"+serviceSID+"/Sessions"), &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ProxySessionsResponse)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// UpdateProxySession will update an existing session within the given proxy service in Twilio based on the provided identifier and options. Setting the Status to ClosedSession ends the session, while InProgressSession re-opens a closed one.
+func (twilio *Twilio) UpdateProxySession(serviceSID, sessionSID string, options ProxySessionOptions) (*ProxySession, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.post(twilio.proxyURL("Services/"+serviceSID+"/Sessions/"+url.PathEscape(sessionSID)), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ProxySession)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// DeleteProxySession will completely remove the session matching the given identifier, along with its participants and interactions, from the given proxy service within Twilio.
+func (twilio *Twilio) DeleteProxySession(serviceSID, sessionSID string) error {
+	res, err := twilio.delete(twilio.proxyURL("Services/" + serviceSID + "/Sessions/" + url.PathEscape(sessionSID)))
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		decoder := json.NewDecoder(res.Body)
+
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return err
+	}
+
+	return nil
+}
+
+// MarshalJSON handles converting a ProxySessionMode into the string representation used by Twilio.
+func (mode ProxySessionMode) MarshalJSON() ([]byte, error) {
+	return json.Marshal(mode.String())
+}
+
+// UnmarshalJSON handles converting the string representation used by Twilio into a ProxySessionMode.
+func (mode *ProxySessionMode) UnmarshalJSON(b []byte) error {
+	var s string
+
+	err := json.Unmarshal(b, &s)
+
+	if err != nil {
+		return err
+	}
+
+	*mode = ConvertModeToProxySessionMode(s)
+
+	return nil
+}
+
+func (mode ProxySessionMode) String() string {
+	return map[ProxySessionMode]string{
+		MessageOnlySession:     "message-only",
+		VoiceOnlySession:       "voice-only",
+		VoiceAndMessageSession: "voice-and-message",
+	}[mode]
+}
+
+// MarshalJSON handles converting a ProxySessionStatus into the string representation used by Twilio.
+func (status ProxySessionStatus) MarshalJSON() ([]byte, error) {
+	return json.Marshal(status.String())
+}
+
+// UnmarshalJSON handles converting the string representation used by Twilio into a ProxySessionStatus.
+func (status *ProxySessionStatus) UnmarshalJSON(b []byte) error {
+	var s string
+
+	err := json.Unmarshal(b, &s)
+
+	if err != nil {
+		return err
+	}
+
+	*status = ConvertStatusToProxySessionStatus(s)
+
+	return nil
+}
+
+func (status ProxySessionStatus) String() string {
+	return map[ProxySessionStatus]string{
+		OpenSession:       "open",
+		InProgressSession: "in-progress",
+		ClosedSession:     "closed",
+		FailedSession:     "failed",
+		UnknownSession:    "unknown",
+	}[status]
+}
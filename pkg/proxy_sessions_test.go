@@ -0,0 +1,195 @@
+package twiligo_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	twiligo "github.com/craigpaul/twiligo/pkg"
+)
+
+const proxySessionResponse = `{
+	"sid": "KCXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"account_sid": "ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"service_sid": "KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"unique_name": "order-1234",
+	"mode": "message-only",
+	"status": "open",
+	"closed_reason": null,
+	"ttl": 3600,
+	"date_started": null,
+	"date_ended": null,
+	"date_last_interaction": null,
+	"date_expiry": "2020-07-31T00:00:00Z",
+	"date_created": "2020-07-30T00:00:00Z",
+	"date_updated": "2020-07-30T00:00:00Z",
+	"url": "https://proxy.twilio.com/v1/Services/KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Sessions/KCXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	"links": {
+		"interactions": "https://proxy.twilio.com/v1/Services/KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Sessions/KCXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Interactions",
+		"participants": "https://proxy.twilio.com/v1/Services/KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Sessions/KCXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Participants"
+	}
+}`
+
+const proxySessionNotFoundResponse = `{
+	"code": 20404,
+	"message": "The requested resource /Services/KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Sessions/order-1234 was not found",
+	"more_info": "https://www.twilio.com/docs/errors/20404",
+	"status": 404
+}`
+
+func TestWillMakeRequestToCreateNewProxySessionSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Services/KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Sessions"
+
+		if req.URL.Path != expected {
+			t.Logf("Incorrect URL supplied, expecting URL to be [%s], but received [%s]", expected, req.URL.Path)
+			t.Fail()
+		}
+
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		expectedParams := map[string]string{
+			"UniqueName": "order-1234",
+			"Mode":       "message-only",
+			"Ttl":        "3600",
+			"DateExpiry": "2020-07-31T00:00:00Z",
+		}
+
+		for key, value := range expectedParams {
+			if params.Get(key) != value {
+				t.Logf("Incorrect request parameter %s supplied, expecting [%s], but received [%s]", key, value, params.Get(key))
+				t.Fail()
+			}
+		}
+
+		if _, ok := params["Status"]; ok {
+			t.Log("Empty request parameter Status was incorrectly supplied")
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(proxySessionResponse)),
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.CreateNewProxySession("KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.ProxySessionOptions{
+		UniqueName: "order-1234",
+		Mode:       twiligo.MessageOnlySession,
+		TTL:        3600,
+		DateExpiry: time.Date(2020, 7, 31, 0, 0, 0, 0, time.UTC),
+	})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if response.Mode != twiligo.MessageOnlySession || response.Status != twiligo.OpenSession {
+		t.Logf("Incorrect session decoded, received [%v]", response)
+		t.Fail()
+	}
+
+	if response.DateStarted != nil || response.DateExpiry == nil || response.DateExpiry.Day() != 31 {
+		t.Logf("Incorrect session dates decoded, received [%v]", response)
+		t.Fail()
+	}
+}
+
+func TestWillHandleErrorResponsesWhenMakingRequestToFetchProxySession(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(proxySessionNotFoundResponse)),
+			StatusCode: http.StatusNotFound,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.FetchProxySession("KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "order-1234")
+
+	if response != nil {
+		t.Logf("Response was incorrectly returned, was not expecting the following response: %v", response)
+		t.Fail()
+	}
+
+	if exception, ok := err.(*twiligo.Exception); ok == false || exception.Code != 20404 {
+		t.Logf("Incorrect error returned, received [%v]", err)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToListProxySessionsSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(`{"sessions": [` + proxySessionResponse + `], "meta": {"page": 0, "page_size": 50, "key": "sessions"}}`)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	response, err := twilio.ListProxySessions("KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.PageOptions{})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if len(response.Sessions) != 1 {
+		t.Logf("Incorrect sessions decoded, received [%v]", response.Sessions)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToCloseProxySessionSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		body, _ := ioutil.ReadAll(req.Body)
+		params, _ := url.ParseQuery(string(body))
+
+		if params.Get("Status") != "closed" {
+			t.Logf("Incorrect request parameter supplied, expecting [%s], but received [%s]", "closed", params.Get("Status"))
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(proxySessionResponse)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	_, err := twilio.UpdateProxySession("KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "KCXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", twiligo.ProxySessionOptions{Status: twiligo.ClosedSession})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+}
+
+func TestWillMakeRequestToDeleteProxySessionSuccessfully(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		expected := "/v1/Services/KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Sessions/KCXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+
+		if req.Method != http.MethodDelete || req.URL.Path != expected {
+			t.Logf("Incorrect request supplied, expecting [DELETE %s], but received [%s %s]", expected, req.Method, req.URL.Path)
+			t.Fail()
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(``)),
+			StatusCode: http.StatusNoContent,
+			Header:     make(http.Header),
+		}
+	})
+
+	err := twilio.DeleteProxySession("KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "KCXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX")
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.Fail()
+	}
+}
@@ -0,0 +1,117 @@
+package twiligo
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/google/go-querystring/query"
+)
+
+// ProxyShortCode represents a short code that has been attached to a ProxyService within Twilio.
+type ProxyShortCode struct {
+	SID          string                       `json:"sid"`
+	AccountSID   string                       `json:"account_sid"`
+	ServiceSID   string                       `json:"service_sid"`
+	DateCreated  time.Time                    `json:"date_created"`
+	DateUpdated  time.Time                    `json:"date_updated"`
+	ShortCode    string                       `json:"short_code"`
+	IsoCountry   string                       `json:"iso_country"`
+	Capabilities ProxyPhoneNumberCapabilities `json:"capabilities"`
+	URL          string                       `json:"url"`
+	IsReserved   bool                         `json:"is_reserved"`
+}
+
+// ProxyShortCodesResponse is the representation of the JSON response from Twilio when listing the short codes of a proxy service.
+type ProxyShortCodesResponse struct {
+	ShortCodes []*ProxyShortCode `json:"short_codes"`
+	Meta       Meta              `json:"meta"`
+}
+
+// AddShortCodeToProxyService attaches the short code matching the given identifier to the given proxy service in Twilio.
+func (twilio *Twilio) AddShortCodeToProxyService(serviceSID, shortCodeSID string) (*ProxyShortCode, error) {
+	params := url.Values{}
+
+	params.Add("Sid", shortCodeSID)
+
+	res, err := twilio.post(twilio.proxyURL("Services/"+serviceSID+"/ShortCodes"), params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusCreated {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ProxyShortCode)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// ListProxyShortCodes retrieves a single page of the short codes attached to the given proxy service from Twilio.
+func (twilio *Twilio) ListProxyShortCodes(serviceSID string, options PageOptions) (*ProxyShortCodesResponse, error) {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := twilio.get(twilio.proxyURL("Services/"+serviceSID+"/ShortCodes"), &params)
+
+	if err != nil {
+		return nil, err
+	}
+
+	defer res.Body.Close()
+
+	decoder := json.NewDecoder(res.Body)
+
+	if res.StatusCode != http.StatusOK {
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return nil, err
+	}
+
+	response := new(ProxyShortCodesResponse)
+
+	decoder.Decode(&response)
+
+	return response, nil
+}
+
+// RemoveShortCodeFromProxyService removes the given short code from the given proxy service within Twilio.
+func (twilio *Twilio) RemoveShortCodeFromProxyService(serviceSID, shortCodeSID string) error {
+	res, err := twilio.delete(twilio.proxyURL("Services/" + serviceSID + "/ShortCodes/" + shortCodeSID))
+
+	if err != nil {
+		return err
+	}
+
+	defer res.Body.Close()
+
+	if res.StatusCode != http.StatusNoContent {
+		decoder := json.NewDecoder(res.Body)
+
+		err = new(Exception)
+
+		decoder.Decode(err)
+
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,145 @@
+package twiligo
+
+import (
+	"net/http"
+)
+
+// ProxyInteractionWebhook holds the fields that Twilio includes with every Proxy webhook describing an interaction between the participants of a session.
+type ProxyInteractionWebhook struct {
+	AccountSID             string               `json:"interactionAccountSid"`
+	ServiceSID             string               `json:"interactionServiceSid"`
+	SessionSID             string               `json:"interactionSessionSid"`
+	InteractionSID         string               `json:"interactionSid"`
+	InteractionType        ProxyInteractionType `json:"interactionType"`
+	InteractionData        string               `json:"interactionData"`
+	InboundParticipantSID  string               `json:"inboundParticipantSid"`
+	InboundResourceSID     string               `json:"inboundResourceSid"`
+	InboundResourceStatus  ProxyResourceStatus  `json:"inboundResourceStatus"`
+	InboundResourceType    string               `json:"inboundResourceType"`
+	InboundResourceURL     string               `json:"inboundResourceUrl"`
+	OutboundParticipantSID string               `json:"outboundParticipantSid"`
+	OutboundResourceSID    string               `json:"outboundResourceSid"`
+	OutboundResourceStatus ProxyResourceStatus  `json:"outboundResourceStatus"`
+	OutboundResourceType   string               `json:"outboundResourceType"`
+	OutboundResourceURL    string               `json:"outboundResourceUrl"`
+}
+
+// ProxyCallback represents the webhook sent from Twilio to the CallbackURL of a ProxyService whenever an interaction changes status, or whenever a session changes status, in which case only the session fields are populated. SessionSID is set for both kinds of callback.
+type ProxyCallback struct {
+	ProxyInteractionWebhook
+	SessionSID          string             `json:"sessionSid"`
+	SessionUniqueName   string             `json:"sessionUniqueName"`
+	SessionStatus       ProxySessionStatus `json:"sessionStatus"`
+	SessionClosedReason string             `json:"sessionClosedReason"`
+}
+
+// ProxyInterceptCallback represents the webhook sent from Twilio to the InterceptCallbackURL of a ProxyService before an interaction is forwarded to the other participant. The outbound fields are empty as nothing has been forwarded yet.
+type ProxyInterceptCallback struct {
+	ProxyInteractionWebhook
+}
+
+// ProxyInterceptHandlerFunc handles a single Proxy intercept webhook, deciding whether the interaction should be forwarded via the given response writer.
+type ProxyInterceptHandlerFunc func(callback *ProxyInterceptCallback, w *ProxyInterceptResponseWriter)
+
+// ProxyInterceptResponseWriter writes the response to a Proxy intercept webhook, telling Twilio whether the interaction should be forwarded to the other participant. Only the first call to Allow or Block takes effect.
+type ProxyInterceptResponseWriter struct {
+	*preEventResponseWriter
+}
+
+// ProxyOutOfSessionCallback represents the webhook sent from Twilio to the OutOfSessionCallbackURL of a ProxyService when a message or call reaches one of its proxy numbers without belonging to an active session. MessageSID is set for messages and CallSID for calls.
+type ProxyOutOfSessionCallback struct {
+	AccountSID string `json:"AccountSid"`
+	MessageSID string `json:"MessageSid"`
+	CallSID    string `json:"CallSid"`
+	CallStatus string `json:"CallStatus"`
+	From       string `json:"From"`
+	To         string `json:"To"`
+	Body       string `json:"Body"`
+	NumMedia   int    `json:"NumMedia"`
+}
+
+// ParseProxyCallback parses the body of a webhook sent to the CallbackURL of a ProxyService.
+func ParseProxyCallback(r *http.Request) (*ProxyCallback, error) {
+	err := r.ParseForm()
+
+	if err != nil {
+		return nil, err
+	}
+
+	callback := new(ProxyCallback)
+
+	err = decodeWebhookValues(r.PostForm, callback)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if callback.SessionSID == "" {
+		callback.SessionSID = callback.ProxyInteractionWebhook.SessionSID
+	}
+
+	return callback, nil
+}
+
+// ParseProxyInterceptCallback parses the body of a webhook sent to the InterceptCallbackURL of a ProxyService.
+func ParseProxyInterceptCallback(r *http.Request) (*ProxyInterceptCallback, error) {
+	err := r.ParseForm()
+
+	if err != nil {
+		return nil, err
+	}
+
+	callback := new(ProxyInterceptCallback)
+
+	err = decodeWebhookValues(r.PostForm, callback)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return callback, nil
+}
+
+// ParseProxyOutOfSessionCallback parses the body of a webhook sent to the OutOfSessionCallbackURL of a ProxyService.
+func ParseProxyOutOfSessionCallback(r *http.Request) (*ProxyOutOfSessionCallback, error) {
+	err := r.ParseForm()
+
+	if err != nil {
+		return nil, err
+	}
+
+	callback := new(ProxyOutOfSessionCallback)
+
+	err = decodeWebhookValues(r.PostForm, callback)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return callback, nil
+}
+
+// NewProxyInterceptHandler creates an http.Handler for Proxy intercept webhooks. Requests are verified with CheckSignature against the given base URL before being parsed and passed along to the given function, and interactions are forwarded unless the function blocks them.
+func (twilio *Twilio) NewProxyInterceptHandler(baseURL string, handle ProxyInterceptHandlerFunc) http.Handler {
+	return twilio.newSignedWebhookHandler(baseURL, func(r *http.Request, response *preEventResponseWriter) error {
+		callback, err := ParseProxyInterceptCallback(r)
+
+		if err != nil {
+			return err
+		}
+
+		handle(callback, &ProxyInterceptResponseWriter{response})
+
+		return nil
+	})
+}
+
+// Allow lets the interaction be forwarded to the other participant.
+func (response *ProxyInterceptResponseWriter) Allow() {
+	response.writeStatus(http.StatusOK)
+}
+
+// Block stops the interaction from being forwarded to the other participant.
+func (response *ProxyInterceptResponseWriter) Block() {
+	response.writeStatus(http.StatusForbidden)
+}
@@ -0,0 +1,148 @@
+package twiligo_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	twiligo "github.com/craigpaul/twiligo/pkg"
+)
+
+func TestWillParseProxyInteractionCallback(t *testing.T) {
+	req := NewTestWebhookRequest(url.Values{
+		"interactionAccountSid":  {"ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"},
+		"interactionServiceSid":  {"KSXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"},
+		"interactionSessionSid":  {"KCXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"},
+		"interactionSid":         {"KIXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"},
+		"interactionType":        {"message"},
+		"interactionData":        {`{"body":"Hello"}`},
+		"inboundParticipantSid":  {"KPXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"},
+		"inboundResourceStatus":  {"received"},
+		"outboundParticipantSid": {"KPYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY"},
+		"outboundResourceStatus": {"delivered"},
+	})
+
+	callback, err := twiligo.ParseProxyCallback(req)
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if callback.InteractionType != twiligo.MessageProxyInteraction || callback.InboundResourceStatus != twiligo.ReceivedResource || callback.OutboundResourceStatus != twiligo.DeliveredResource {
+		t.Logf("Incorrect interaction decoded, received [%v]", callback)
+		t.Fail()
+	}
+
+	if callback.SessionSID != "KCXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX" || callback.OutboundParticipantSID != "KPYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY" {
+		t.Logf("Incorrect session or participant decoded, received [%v]", callback)
+		t.Fail()
+	}
+}
+
+func TestWillParseProxySessionStatusCallback(t *testing.T) {
+	req := NewTestWebhookRequest(url.Values{
+		"sessionSid":          {"KCXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"},
+		"sessionUniqueName":   {"order-1234"},
+		"sessionStatus":       {"closed"},
+		"sessionClosedReason": {"ttl"},
+	})
+
+	callback, err := twiligo.ParseProxyCallback(req)
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if callback.SessionStatus != twiligo.ClosedSession || callback.SessionClosedReason != "ttl" || callback.InteractionSID != "" {
+		t.Logf("Incorrect session status decoded, received [%v]", callback)
+		t.Fail()
+	}
+}
+
+func TestWillParseProxyOutOfSessionCallback(t *testing.T) {
+	req := NewTestWebhookRequest(url.Values{
+		"AccountSid": {"ACXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"},
+		"MessageSid": {"SMXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"},
+		"From":       {"+15555555555"},
+		"To":         {"+15555555556"},
+		"Body":       {"Is my order still coming?"},
+		"NumMedia":   {"0"},
+	})
+
+	callback, err := twiligo.ParseProxyOutOfSessionCallback(req)
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if callback.MessageSID != "SMXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX" || callback.CallSID != "" || callback.To != "+15555555556" {
+		t.Logf("Incorrect callback decoded, received [%v]", callback)
+		t.Fail()
+	}
+}
+
+func TestWillAllowProxyInteractionWhenInterceptHandlerDoesNotRespond(t *testing.T) {
+	twilio := twiligo.New("123", "456")
+
+	handler := twilio.NewProxyInterceptHandler("https://example.com", func(callback *twiligo.ProxyInterceptCallback, w *twiligo.ProxyInterceptResponseWriter) {
+		if callback.InboundParticipantSID != "KPXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX" {
+			t.Logf("Incorrect inbound participant received, expected [%s], but received [%s]", "KPXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", callback.InboundParticipantSID)
+			t.Fail()
+		}
+	})
+
+	recorder := httptest.NewRecorder()
+
+	handler.ServeHTTP(recorder, NewSignedTestWebhookRequest(twilio, url.Values{
+		"interactionSid":        {"KIXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"},
+		"inboundParticipantSid": {"KPXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"},
+	}))
+
+	if recorder.Code != http.StatusOK {
+		t.Logf("Incorrect status code written, expected [%d], but received [%d]", http.StatusOK, recorder.Code)
+		t.Fail()
+	}
+}
+
+func TestWillBlockProxyInteractionWhenInterceptHandlerBlocksIt(t *testing.T) {
+	twilio := twiligo.New("123", "456")
+
+	handler := twilio.NewProxyInterceptHandler("https://example.com", func(callback *twiligo.ProxyInterceptCallback, w *twiligo.ProxyInterceptResponseWriter) {
+		w.Block()
+		w.Allow()
+	})
+
+	recorder := httptest.NewRecorder()
+
+	handler.ServeHTTP(recorder, NewSignedTestWebhookRequest(twilio, url.Values{
+		"interactionSid":  {"KIXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"},
+		"interactionType": {"voice"},
+	}))
+
+	if recorder.Code != http.StatusForbidden {
+		t.Logf("Incorrect status code written, expected [%d], but received [%d]", http.StatusForbidden, recorder.Code)
+		t.Fail()
+	}
+}
+
+func TestWillRefuseProxyInterceptWithInvalidSignature(t *testing.T) {
+	twilio := twiligo.New("123", "456")
+
+	handler := twilio.NewProxyInterceptHandler("https://example.com", func(callback *twiligo.ProxyInterceptCallback, w *twiligo.ProxyInterceptResponseWriter) {
+		t.Log("Handler was incorrectly called for a request with an invalid signature")
+		t.Fail()
+	})
+
+	recorder := httptest.NewRecorder()
+
+	handler.ServeHTTP(recorder, NewTestWebhookRequest(url.Values{"interactionSid": {"KIXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"}}))
+
+	if recorder.Code != http.StatusForbidden {
+		t.Logf("Incorrect status code written, expected [%d], but received [%d]", http.StatusForbidden, recorder.Code)
+		t.Fail()
+	}
+}
@@ -0,0 +1,96 @@
+package twiligo
+
+import (
+	"fmt"
+)
+
+// FindOrCreateSMSConversation returns the conversation between the given customer phone number and business phone number. An existing conversation is reused when one is found (inactive conversations are re-activated), otherwise a new conversation is created with the given options and the customer is added to it as an SMS participant. If the participant cannot be added, the newly created conversation is removed again so that no empty conversations are left behind.
+func (twilio *Twilio) FindOrCreateSMSConversation(customerNumber, businessNumber string, options ConversationOptions) (*Conversation, error) {
+	conversationSID, err := twilio.findSMSConversation(customerNumber, businessNumber)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if conversationSID != "" {
+		return twilio.activateConversation(conversationSID)
+	}
+
+	conversation, err := twilio.CreateNewConversation(options)
+
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = twilio.CreateNewConversationParticipant(conversation.SID, ConversationParticipantOptions{
+		MessagingBindingAddress:      customerNumber,
+		MessagingBindingProxyAddress: businessNumber,
+	})
+
+	if err == nil {
+		return conversation, nil
+	}
+
+	cleanupErr := twilio.DeleteConversation(conversation.SID)
+
+	if cleanupErr != nil {
+		return nil, fmt.Errorf("Unable to remove conversation %s after failing to add participant (%s): %w", conversation.SID, cleanupErr, err)
+	}
+
+	if existingSID, ok := ExistingConversationSID(err); ok {
+		return twilio.activateConversation(existingSID)
+	}
+
+	return nil, err
+}
+
+func (twilio *Twilio) findSMSConversation(customerNumber, businessNumber string) (string, error) {
+	inactiveSID := ""
+	options := ListParticipantConversationsOptions{Address: customerNumber}
+
+	for {
+		response, err := twilio.ListParticipantConversations(options)
+
+		if err != nil {
+			return "", err
+		}
+
+		for _, conversation := range response.Conversations {
+			binding := conversation.ParticipantMessagingBinding
+
+			if binding == nil || binding.ProxyAddress != businessNumber {
+				continue
+			}
+
+			if conversation.ConversationState == ActiveConversation {
+				return conversation.ConversationSID, nil
+			}
+
+			if conversation.ConversationState == InactiveConversation && inactiveSID == "" {
+				inactiveSID = conversation.ConversationSID
+			}
+		}
+
+		next := response.Meta.NextPage()
+
+		if next == nil {
+			return inactiveSID, nil
+		}
+
+		options.PageOptions = *next
+	}
+}
+
+func (twilio *Twilio) activateConversation(conversationSID string) (*Conversation, error) {
+	conversation, err := twilio.FetchConversation(conversationSID)
+
+	if err != nil {
+		return nil, err
+	}
+
+	if conversation.State == ActiveConversation {
+		return conversation, nil
+	}
+
+	return twilio.UpdateConversation(conversationSID, ConversationOptions{State: ActiveConversation})
+}
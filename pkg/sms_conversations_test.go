@@ -0,0 +1,328 @@
+package twiligo_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"testing"
+
+	twiligo "github.com/craigpaul/twiligo/pkg"
+)
+
+func TestWillReuseActiveConversationWhenFindingOrCreatingSMSConversation(t *testing.T) {
+	requests := []string{}
+
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+
+		response := participantConversationsResponse
+
+		if strings.HasSuffix(req.URL.Path, "/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX") {
+			response = createdConversationResponse
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(response)),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	conversation, err := twilio.FindOrCreateSMSConversation("+15555555555", "+16666666666", twiligo.ConversationOptions{})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if conversation.SID != "CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX" {
+		t.Logf("Incorrect conversation returned, expected [%s], but received [%s]", "CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", conversation.SID)
+		t.Fail()
+	}
+
+	expected := []string{
+		"GET /v1/ParticipantConversations",
+		"GET /v1/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+	}
+
+	if strings.Join(requests, ",") != strings.Join(expected, ",") {
+		t.Logf("Incorrect requests made, expected [%v], but received [%v]", expected, requests)
+		t.Fail()
+	}
+}
+
+func TestWillCreateConversationAndParticipantWhenNoConversationExistsForNumbers(t *testing.T) {
+	requests := []string{}
+
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+
+		switch req.URL.Path {
+		case "/v1/ParticipantConversations":
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(strings.Replace(participantConversationsResponse, "+16666666666", "+17777777777", 1))),
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+			}
+		case "/v1/Conversations":
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(createdConversationResponse)),
+				StatusCode: http.StatusCreated,
+				Header:     make(http.Header),
+			}
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(createdConversationParticipantResponse)),
+			StatusCode: http.StatusCreated,
+			Header:     make(http.Header),
+		}
+	})
+
+	conversation, err := twilio.FindOrCreateSMSConversation("+15555555555", "+16666666666", twiligo.ConversationOptions{})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if conversation == nil {
+		t.Log("Did not receive the expected conversation")
+		t.Fail()
+	}
+
+	expected := []string{
+		"GET /v1/ParticipantConversations",
+		"POST /v1/Conversations",
+		"POST /v1/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Participants",
+	}
+
+	if strings.Join(requests, ",") != strings.Join(expected, ",") {
+		t.Logf("Incorrect requests made, expected [%v], but received [%v]", expected, requests)
+		t.Fail()
+	}
+}
+
+func TestWillRemoveNewConversationAndReuseExistingConversationWhenParticipantBindingAlreadyExists(t *testing.T) {
+	requests := []string{}
+
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+
+		switch req.Method + " " + req.URL.Path {
+		case "GET /v1/ParticipantConversations":
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{"conversations": [], "meta": {"page": 0, "page_size": 50}}`)),
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+			}
+		case "POST /v1/Conversations":
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(createdConversationResponse)),
+				StatusCode: http.StatusCreated,
+				Header:     make(http.Header),
+			}
+		case "POST /v1/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Participants":
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(duplicateParticipantBindingResponse)),
+				StatusCode: http.StatusConflict,
+				Header:     make(http.Header),
+			}
+		case "DELETE /v1/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX":
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(``)),
+				StatusCode: http.StatusNoContent,
+				Header:     make(http.Header),
+			}
+		case "GET /v1/Conversations/CHYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY":
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(strings.NewReplacer("CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "CHYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY", `"state": "closed"`, `"state": "inactive"`).Replace(updatedConversationResponse))),
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+			}
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(strings.Replace(createdConversationResponse, "CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX", "CHYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY", -1))),
+			StatusCode: http.StatusOK,
+			Header:     make(http.Header),
+		}
+	})
+
+	conversation, err := twilio.FindOrCreateSMSConversation("+15555555555", "+16666666666", twiligo.ConversationOptions{})
+
+	if err != nil {
+		t.Logf("Error was incorrectly returned, was not expecting the following error: %s", err)
+		t.FailNow()
+	}
+
+	if conversation.SID != "CHYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY" || conversation.State != twiligo.ActiveConversation {
+		t.Logf("Incorrect conversation returned, expected active [%s], but received %s [%s]", "CHYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY", conversation.State, conversation.SID)
+		t.Fail()
+	}
+
+	expected := []string{
+		"GET /v1/ParticipantConversations",
+		"POST /v1/Conversations",
+		"POST /v1/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX/Participants",
+		"DELETE /v1/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX",
+		"GET /v1/Conversations/CHYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY",
+		"POST /v1/Conversations/CHYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY",
+	}
+
+	if strings.Join(requests, ",") != strings.Join(expected, ",") {
+		t.Logf("Incorrect requests made, expected [%v], but received [%v]", expected, requests)
+		t.Fail()
+	}
+}
+
+func TestWillRemoveNewConversationAndReturnErrorWhenParticipantCannotBeAdded(t *testing.T) {
+	deleted := false
+
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		switch req.Method + " " + req.URL.Path {
+		case "GET /v1/ParticipantConversations":
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{"conversations": [], "meta": {"page": 0, "page_size": 50}}`)),
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+			}
+		case "POST /v1/Conversations":
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(createdConversationResponse)),
+				StatusCode: http.StatusCreated,
+				Header:     make(http.Header),
+			}
+		case "DELETE /v1/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX":
+			deleted = true
+
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(``)),
+				StatusCode: http.StatusNoContent,
+				Header:     make(http.Header),
+			}
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(attributesAreJsonResponse)),
+			StatusCode: http.StatusBadRequest,
+			Header:     make(http.Header),
+		}
+	})
+
+	conversation, err := twilio.FindOrCreateSMSConversation("+15555555555", "+16666666666", twiligo.ConversationOptions{})
+
+	if conversation != nil {
+		t.Logf("Conversation was incorrectly returned, was not expecting the following conversation: %v", conversation)
+		t.Fail()
+	}
+
+	expected := "Attributes not valid JSON"
+
+	if err == nil || err.Error() != expected {
+		t.Logf("Incorrect error returned, expected [%s], but received [%v]", expected, err)
+		t.Fail()
+	}
+
+	if deleted == false {
+		t.Log("Expected the newly created conversation to be removed, but it was not")
+		t.Fail()
+	}
+}
+
+func TestWillReturnCleanupErrorWhenNewConversationCannotBeRemoved(t *testing.T) {
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		switch req.Method + " " + req.URL.Path {
+		case "GET /v1/ParticipantConversations":
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{"conversations": [], "meta": {"page": 0, "page_size": 50}}`)),
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+			}
+		case "POST /v1/Conversations":
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(createdConversationResponse)),
+				StatusCode: http.StatusCreated,
+				Header:     make(http.Header),
+			}
+		case "DELETE /v1/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX":
+			return nil
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(attributesAreJsonResponse)),
+			StatusCode: http.StatusBadRequest,
+			Header:     make(http.Header),
+		}
+	})
+
+	conversation, err := twilio.FindOrCreateSMSConversation("+15555555555", "+16666666666", twiligo.ConversationOptions{})
+
+	if conversation != nil {
+		t.Logf("Conversation was incorrectly returned, was not expecting the following conversation: %v", conversation)
+		t.Fail()
+	}
+
+	expected := "Unable to remove conversation CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+
+	if err == nil || strings.HasPrefix(err.Error(), expected) == false {
+		t.Logf("Incorrect error returned, expected [%s], but received [%v]", expected, err)
+		t.Fail()
+	}
+}
+
+func TestWillReturnBindingErrorWhenExistingConversationCannotBeIdentified(t *testing.T) {
+	deleted := false
+
+	twilio := NewTestTwilio(func(req *http.Request) *http.Response {
+		switch req.Method + " " + req.URL.Path {
+		case "GET /v1/ParticipantConversations":
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(`{"conversations": [], "meta": {"page": 0, "page_size": 50}}`)),
+				StatusCode: http.StatusOK,
+				Header:     make(http.Header),
+			}
+		case "POST /v1/Conversations":
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(createdConversationResponse)),
+				StatusCode: http.StatusCreated,
+				Header:     make(http.Header),
+			}
+		case "DELETE /v1/Conversations/CHXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX":
+			deleted = true
+
+			return &http.Response{
+				Body:       ioutil.NopCloser(bytes.NewBufferString(``)),
+				StatusCode: http.StatusNoContent,
+				Header:     make(http.Header),
+			}
+		}
+
+		return &http.Response{
+			Body:       ioutil.NopCloser(bytes.NewBufferString(strings.Replace(duplicateParticipantBindingResponse, " in Conversation CHYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY", "", 1))),
+			StatusCode: http.StatusConflict,
+			Header:     make(http.Header),
+		}
+	})
+
+	conversation, err := twilio.FindOrCreateSMSConversation("+15555555555", "+16666666666", twiligo.ConversationOptions{})
+
+	if conversation != nil {
+		t.Logf("Conversation was incorrectly returned, was not expecting the following conversation: %v", conversation)
+		t.Fail()
+	}
+
+	var exception *twiligo.Exception
+
+	if errors.As(err, &exception) == false || exception.Code != twiligo.DuplicateParticipantBindingErrorCode {
+		t.Logf("Incorrect error returned, expected the duplicate binding exception, but received [%v]", err)
+		t.Fail()
+	}
+
+	if deleted == false {
+		t.Log("Expected the newly created conversation to be removed, but it was not")
+		t.Fail()
+	}
+}
@@ -1,17 +1,22 @@
 package twiligo
 
 import (
+	"errors"
+	"io"
 	"net/http"
 	"net/url"
 	"path"
 	"strings"
 	"time"
+
+	"github.com/google/go-querystring/query"
 )
 
 const (
 	baseURL             string = "https://api.twilio.com/2010-04-01"
 	chatBaseURL         string = "https://chat.twilio.com/v2"
 	conversationBaseURL string = "https://conversations.twilio.com/v1"
+	mediaBaseURL        string = "https://mcs.us1.twilio.com/v1"
 	proxyBaseURL        string = "https://proxy.twilio.com/v1"
 )
 
@@ -25,9 +30,10 @@ type Exception struct {
 
 // Twilio holds the necessary important information for connecting to the Twilio REST API.
 type Twilio struct {
-	AccountSID string
-	AuthToken  string
-	HTTPClient *http.Client
+	AccountSID             string
+	AuthToken              string
+	ConversationServiceSID string
+	HTTPClient             *http.Client
 }
 
 // Error will print the current exception as a string.
@@ -53,6 +59,15 @@ func NewCustomClient(accountSID, authToken string, HTTPClient *http.Client) *Twi
 	}
 }
 
+// WithConversationService creates a copy of the current instance of Twilio that will direct all of its conversation related requests at the Conversation Service matching the given identifier instead of the default service for the account.
+func (twilio *Twilio) WithConversationService(serviceSID string) *Twilio {
+	scoped := *twilio
+
+	scoped.ConversationServiceSID = serviceSID
+
+	return &scoped
+}
+
 func (twilio *Twilio) credentials() (string, string) {
 	return twilio.AccountSID, twilio.AuthToken
 }
@@ -74,13 +89,17 @@ func (twilio *Twilio) get(resource string, values *url.Values) (*http.Response,
 }
 
 func (twilio *Twilio) post(resource string, values url.Values) (*http.Response, error) {
-	req, err := http.NewRequest(http.MethodPost, resource, strings.NewReader(values.Encode()))
+	return twilio.postBody(resource, "application/x-www-form-urlencoded", strings.NewReader(values.Encode()))
+}
+
+func (twilio *Twilio) postBody(resource, contentType string, body io.Reader) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodPost, resource, body)
 
 	if err != nil {
 		return nil, err
 	}
 
-	req.Header.Add("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Add("Content-Type", contentType)
 
 	req.SetBasicAuth(twilio.credentials())
 
@@ -107,6 +126,22 @@ func (twilio *Twilio) conversationURL(resource string) string {
 	return conversationBaseURL + "/" + resource
 }
 
+func (twilio *Twilio) scopedConversationURL(resource string) string {
+	if twilio.ConversationServiceSID == "" {
+		return twilio.conversationURL(resource)
+	}
+
+	return twilio.conversationURL(path.Join("Services", twilio.ConversationServiceSID, resource))
+}
+
+func (twilio *Twilio) mediaURL(serviceSID, resource string) (string, error) {
+	if serviceSID == "" {
+		return "", errors.New("Missing required parameter ConversationServiceSID")
+	}
+
+	return mediaBaseURL + "/" + path.Join("Services", serviceSID, resource), nil
+}
+
 func (twilio *Twilio) proxyURL(resource string) string {
 	return proxyBaseURL + "/" + resource
 }
@@ -114,3 +149,19 @@ func (twilio *Twilio) proxyURL(resource string) string {
 func (twilio *Twilio) url(resource string) string {
 	return baseURL + "/" + path.Join("Accounts", twilio.AccountSID, resource)
 }
+
+func encodeNestedValues(key string, options interface{}, v *url.Values) error {
+	params, err := query.Values(options)
+
+	if err != nil {
+		return err
+	}
+
+	for name, values := range params {
+		for _, value := range values {
+			v.Add(key+"."+name, value)
+		}
+	}
+
+	return nil
+}
@@ -1,5 +1,15 @@
 package twiligo
 
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
 // SmsWebhook represents the response structure sent from Twilio for incoming SMS webhooks.
 type SmsWebhook struct {
 	AccountSID    string `json:"AccountSID"`
@@ -22,3 +32,149 @@ type SmsWebhook struct {
 	ToState       string `json:"ToState"`
 	ToZip         string `json:"ToZip"`
 }
+
+type preEventResponseWriter struct {
+	writer  http.ResponseWriter
+	written bool
+}
+
+type signedWebhookHandler struct {
+	twilio  *Twilio
+	baseURL string
+	serve   func(r *http.Request, response *preEventResponseWriter) error
+}
+
+// newSignedWebhookHandler creates an http.Handler that verifies requests with CheckSignature against the given base URL before passing them along to the given function. Requests with an invalid signature are refused, an error returned from the function is answered as a bad request, and a 200 is written when the function did not respond itself.
+func (twilio *Twilio) newSignedWebhookHandler(baseURL string, serve func(r *http.Request, response *preEventResponseWriter) error) http.Handler {
+	return &signedWebhookHandler{
+		twilio:  twilio,
+		baseURL: baseURL,
+		serve:   serve,
+	}
+}
+
+func (handler *signedWebhookHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	valid, err := handler.twilio.CheckSignature(r, handler.baseURL)
+
+	if err != nil || valid == false {
+		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+
+		return
+	}
+
+	response := &preEventResponseWriter{writer: w}
+
+	err = handler.serve(r, response)
+
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+
+		return
+	}
+
+	response.writeStatus(http.StatusOK)
+}
+
+func (response *preEventResponseWriter) writeStatus(statusCode int) {
+	if response.written {
+		return
+	}
+
+	response.written = true
+
+	response.writer.WriteHeader(statusCode)
+}
+
+func (response *preEventResponseWriter) writeJSON(v interface{}) error {
+	if response.written {
+		return nil
+	}
+
+	body, err := json.Marshal(v)
+
+	if err != nil {
+		return err
+	}
+
+	response.written = true
+
+	response.writer.Header().Set("Content-Type", "application/json")
+	response.writer.WriteHeader(http.StatusOK)
+
+	_, err = response.writer.Write(body)
+
+	return err
+}
+
+func decodeWebhookValues(values url.Values, v interface{}) error {
+	return decodeWebhookStruct(values, reflect.ValueOf(v).Elem())
+}
+
+func decodeWebhookStruct(values url.Values, v reflect.Value) error {
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+
+		if field.Anonymous {
+			err := decodeWebhookStruct(values, v.Field(i))
+
+			if err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		name := strings.Split(field.Tag.Get("json"), ",")[0]
+
+		if name == "" || name == "-" || values.Get(name) == "" {
+			continue
+		}
+
+		err := decodeWebhookValue(values.Get(name), v.Field(i))
+
+		if err != nil {
+			return fmt.Errorf("Invalid value provided for %s: %w", name, err)
+		}
+	}
+
+	return nil
+}
+
+func decodeWebhookValue(value string, v reflect.Value) error {
+	if v.Kind() == reflect.Ptr {
+		v.Set(reflect.New(v.Type().Elem()))
+
+		return decodeWebhookValue(value, v.Elem())
+	}
+
+	if unmarshaler, ok := v.Addr().Interface().(json.Unmarshaler); ok {
+		quoted, _ := json.Marshal(value)
+
+		return unmarshaler.UnmarshalJSON(quoted)
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(value)
+	case reflect.Int, reflect.Int64:
+		number, err := strconv.ParseInt(value, 10, 64)
+
+		if err != nil {
+			return err
+		}
+
+		v.SetInt(number)
+	case reflect.Bool:
+		boolean, err := strconv.ParseBool(value)
+
+		if err != nil {
+			return err
+		}
+
+		v.SetBool(boolean)
+	default:
+		return json.Unmarshal([]byte(value), v.Addr().Interface())
+	}
+
+	return nil
+}